@@ -2,11 +2,15 @@ package blobs
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/diff"
 	"github.com/containerd/containerd/mount"
+	"github.com/containerd/continuity/fs"
 	"github.com/moby/buildkit/cache"
 	"github.com/moby/buildkit/snapshot"
 	"github.com/moby/buildkit/util/flightcontrol"
@@ -66,13 +70,31 @@ func getDiffPairs(ctx context.Context, contentStore content.Store, snapshotter s
 	}
 	eg.Go(func() error {
 		dp, err := g.Do(ctx, ref.ID(), func(ctx context.Context) (interface{}, error) {
+			// lazily pulled layers are exported without unpacking them
+			if desc, diffID, ok := cache.GetLazyBlob(ref); ok {
+				return DiffPair{DiffID: diffID, Blobsum: desc.Digest}, nil
+			}
 			diffID, blob, err := snapshotter.GetBlob(ctx, ref.ID())
 			if err != nil {
 				return nil, err
 			}
 			if blob != "" {
 				return DiffPair{DiffID: diffID, Blobsum: blob}, nil
-			} else if !createBlobs {
+			}
+			// merged layers have the same changes as their source layer
+			if src := cache.GetMergeSource(ref); src != "" {
+				diffID, blob, err := snapshotter.GetBlob(ctx, src)
+				if err != nil {
+					return nil, err
+				}
+				if blob != "" {
+					if err := snapshotter.SetBlob(ctx, ref.ID(), diffID, blob); err != nil {
+						return nil, err
+					}
+					return DiffPair{DiffID: diffID, Blobsum: blob}, nil
+				}
+			}
+			if !createBlobs {
 				return nil, errors.WithStack(ErrNoBlobs)
 			}
 			// reference needs to be committed
@@ -90,6 +112,18 @@ func getDiffPairs(ctx context.Context, contentStore content.Store, snapshotter s
 				}
 				defer m.Release()
 			}
+			if deleted := cache.GetDiffDeletes(ref); parent == nil && len(deleted) > 0 {
+				dir, err := deletedFilesDir(deleted)
+				if err != nil {
+					return nil, err
+				}
+				defer os.RemoveAll(dir)
+				lower = []mount.Mount{{
+					Type:    "bind",
+					Source:  dir,
+					Options: []string{"rbind", "ro"},
+				}}
+			}
 			m, err := ref.Mount(ctx, true)
 			if err != nil {
 				return nil, err
@@ -139,6 +173,32 @@ func getDiffPairs(ctx context.Context, contentStore content.Store, snapshotter s
 	return append(diffPairs, currentPair), nil
 }
 
+// deletedFilesDir returns a directory that contains an empty file for every
+// path deleted by a diff layer. Comparing it to the diff layer results in
+// whiteouts for the deleted paths.
+func deletedFilesDir(deleted []string) (string, error) {
+	dir, err := ioutil.TempDir("", "buildkit-diff")
+	if err != nil {
+		return "", err
+	}
+	for _, p := range deleted {
+		target, err := fs.RootPath(dir, p)
+		if err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+		if err := ioutil.WriteFile(target, nil, 0644); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
 func isTypeWindows(ref cache.ImmutableRef) bool {
 	if cache.GetLayerType(ref) == "windows" {
 		return true
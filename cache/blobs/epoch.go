@@ -0,0 +1,174 @@
+package blobs
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/containerd/containerd/archive/compression"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// keyEpochBlob labels a blob with the digest of its copy with clamped
+// timestamps. The gc.ref prefix keeps the copy alive with the original blob.
+const keyEpochBlob = "containerd.io/gc.ref.content.buildkit.epoch."
+
+const gcRootLabel = "containerd.io/gc.root"
+
+// ClampTimestamps returns diff pairs for layers where all file timestamps
+// later than epoch are set to epoch. The converted blobs are stored in the
+// content store, referenced from the original blobs so that they are garbage
+// collected with them, and reused by later calls with the same epoch.
+func ClampTimestamps(ctx context.Context, contentStore content.Store, diffPairs []DiffPair, epoch time.Time) ([]DiffPair, error) {
+	out := make([]DiffPair, len(diffPairs))
+	for i, dp := range diffPairs {
+		key := keyEpochBlob + strconv.FormatInt(epoch.Unix(), 10)
+		res, err := g.Do(ctx, key+":"+dp.Blobsum.String(), func(ctx context.Context) (interface{}, error) {
+			info, err := contentStore.Info(ctx, dp.Blobsum)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not find blob %s from contentstore", dp.Blobsum)
+			}
+			if dgst, ok := info.Labels[key]; ok {
+				if dp, err := epochDiffPair(ctx, contentStore, digest.Digest(dgst)); err == nil {
+					return dp, nil
+				}
+			}
+			converted, err := clampBlob(ctx, contentStore, dp.Blobsum, epoch)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to clamp timestamps of %s", dp.Blobsum)
+			}
+			if info.Labels == nil {
+				info.Labels = map[string]string{}
+			}
+			info.Labels[key] = converted.Blobsum.String()
+			// stores without label support convert the blob on every export
+			if _, err := contentStore.Update(ctx, info, "labels."+key); err != nil {
+				if errdefs.IsFailedPrecondition(err) {
+					return converted, nil
+				}
+				return nil, err
+			}
+			// the copy is referenced by the original blob now and no longer
+			// needs to be a root of its own
+			if err := unrootBlob(ctx, contentStore, converted.Blobsum); err != nil {
+				return nil, err
+			}
+			return converted, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		out[i] = res.(DiffPair)
+	}
+	return out, nil
+}
+
+func epochDiffPair(ctx context.Context, contentStore content.Store, dgst digest.Digest) (DiffPair, error) {
+	info, err := contentStore.Info(ctx, dgst)
+	if err != nil {
+		return DiffPair{}, err
+	}
+	diffID, err := digest.Parse(info.Labels[containerdUncompressed])
+	if err != nil {
+		return DiffPair{}, err
+	}
+	return DiffPair{DiffID: diffID, Blobsum: dgst}, nil
+}
+
+func clampBlob(ctx context.Context, contentStore content.Store, dgst digest.Digest, epoch time.Time) (DiffPair, error) {
+	ra, err := contentStore.ReaderAt(ctx, ocispec.Descriptor{Digest: dgst})
+	if err != nil {
+		return DiffPair{}, err
+	}
+	defer ra.Close()
+
+	r, err := compression.DecompressStream(content.NewReader(ra))
+	if err != nil {
+		return DiffPair{}, err
+	}
+	defer r.Close()
+
+	ref := "epoch-" + strconv.FormatInt(epoch.Unix(), 10) + "-" + dgst.String()
+	cw, err := content.OpenWriter(ctx, contentStore, content.WithRef(ref))
+	if err != nil {
+		return DiffPair{}, err
+	}
+	defer cw.Close()
+	if err := cw.Truncate(0); err != nil {
+		return DiffPair{}, err
+	}
+
+	compressed, err := compression.CompressStream(cw, compression.Gzip)
+	if err != nil {
+		return DiffPair{}, err
+	}
+	dgstr := digest.SHA256.Digester()
+	tw := tar.NewWriter(io.MultiWriter(compressed, dgstr.Hash()))
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return DiffPair{}, err
+		}
+		clampHeader(hdr, epoch)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return DiffPair{}, err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return DiffPair{}, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return DiffPair{}, err
+	}
+	if err := compressed.Close(); err != nil {
+		return DiffPair{}, err
+	}
+
+	diffID := dgstr.Digest()
+	labels := map[string]string{
+		containerdUncompressed: diffID.String(),
+	}
+	if err := cw.Commit(ctx, 0, cw.Digest(), content.WithLabels(labels)); err != nil {
+		if !errdefs.IsAlreadyExists(err) {
+			return DiffPair{}, err
+		}
+	}
+	return DiffPair{DiffID: diffID, Blobsum: cw.Digest()}, nil
+}
+
+// unrootBlob removes the gc.root label that the content store of the worker
+// sets on committed blobs, so that the blob is only kept while it is
+// referenced
+func unrootBlob(ctx context.Context, contentStore content.Store, dgst digest.Digest) error {
+	_, err := contentStore.Update(ctx, content.Info{Digest: dgst}, "labels."+gcRootLabel)
+	if err != nil && !errdefs.IsFailedPrecondition(err) && !errdefs.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func clampHeader(hdr *tar.Header, epoch time.Time) {
+	clamp := func(tm time.Time) time.Time {
+		if tm.After(epoch) {
+			return epoch
+		}
+		return tm
+	}
+	hdr.ModTime = clamp(hdr.ModTime)
+	hdr.AccessTime = clamp(hdr.AccessTime)
+	hdr.ChangeTime = clamp(hdr.ChangeTime)
+	// the times are written from the header fields
+	for _, k := range []string{"mtime", "atime", "ctime"} {
+		delete(hdr.PAXRecords, k)
+	}
+}
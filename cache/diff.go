@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Diff returns a reference without a parent that only contains the changes
+// between lower and upper. Deleted files are not part of the snapshot but are
+// recorded in the metadata so that they are removed when the reference is
+// merged on top of other references and are exported as whiteouts. A nil
+// lower or upper is treated as an empty directory.
+func (cm *cacheManager) Diff(ctx context.Context, lower, upper ImmutableRef, opts ...RefOption) (ImmutableRef, error) {
+	if lower == nil && upper == nil {
+		return nil, nil
+	}
+
+	index := "diff:" + refID(lower) + ":" + refID(upper)
+
+	sis, err := cm.md.Search(index)
+	if err != nil {
+		return nil, err
+	}
+	for _, si := range sis {
+		if ref, err := cm.Get(ctx, si.ID()); err == nil {
+			return ref, nil
+		}
+	}
+
+	mref, err := cm.New(ctx, nil, append([]RefOption{WithDescription("diff " + refID(lower) + " " + refID(upper))}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := applyDiff(ctx, mref, lower, upper)
+	if err != nil {
+		mref.Release(context.TODO())
+		return nil, errors.Wrap(err, "failed to diff")
+	}
+
+	ref, err := mref.Commit(ctx)
+	if err != nil {
+		mref.Release(context.TODO())
+		return nil, err
+	}
+
+	md := ref.Metadata()
+	if err := queueDiffSource(md, index, refID(upper)); err != nil {
+		ref.Release(context.TODO())
+		return nil, err
+	}
+	if err := queueDiffDeletes(md, deleted); err != nil {
+		ref.Release(context.TODO())
+		return nil, err
+	}
+	if err := md.Commit(); err != nil {
+		ref.Release(context.TODO())
+		return nil, err
+	}
+
+	return ref, nil
+}
+
+// applyDiff writes the changes between lower and upper to mref and returns
+// the deleted paths
+func applyDiff(ctx context.Context, mref MutableRef, lower, upper ImmutableRef) ([]string, error) {
+	lowerDir, release, err := mountDirOrEmpty(ctx, lower)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	upperDir, release, err := mountDirOrEmpty(ctx, upper)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	dest, release, err := mountDir(ctx, mref, false)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var deleted []string
+	if err := applyChanges(ctx, lowerDir, upperDir, dest, applyOpt{
+		deleted: func(p string) {
+			deleted = append(deleted, p)
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
@@ -5,9 +5,12 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"github.com/containerd/continuity/fs"
 	"github.com/moby/buildkit/snapshot"
+	"github.com/pkg/errors"
+	"github.com/tonistiigi/fsutil"
 )
 
 type ReadRequest struct {
@@ -20,17 +23,23 @@ type FileRange struct {
 	Length int
 }
 
-func ReadFile(ctx context.Context, ref ImmutableRef, req ReadRequest) ([]byte, error) {
+type ReadDirRequest struct {
+	Path string
+	// IncludePattern is a glob matched against the names of the entries
+	IncludePattern string
+}
+
+func withMount(ctx context.Context, ref ImmutableRef, fn func(root string) error) error {
 	mount, err := ref.Mount(ctx, true)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	lm := snapshot.LocalMounter(mount)
 
 	root, err := lm.Mount()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	defer func() {
@@ -39,33 +48,119 @@ func ReadFile(ctx context.Context, ref ImmutableRef, req ReadRequest) ([]byte, e
 		}
 	}()
 
-	fp, err := fs.RootPath(root, req.Filename)
-	if err != nil {
-		return nil, err
+	if err := fn(root); err != nil {
+		return err
 	}
 
+	if err := lm.Unmount(); err != nil {
+		return err
+	}
+	lm = nil
+	return nil
+}
+
+func ReadFile(ctx context.Context, ref ImmutableRef, req ReadRequest) ([]byte, error) {
 	var dt []byte
 
-	if req.Range == nil {
-		dt, err = ioutil.ReadFile(fp)
+	err := withMount(ctx, ref, func(root string) error {
+		fp, err := fs.RootPath(root, req.Filename)
 		if err != nil {
-			return nil, err
+			return err
 		}
-	} else {
-		f, err := os.Open(fp)
-		if err != nil {
-			return nil, err
+
+		if req.Range == nil {
+			dt, err = ioutil.ReadFile(fp)
+			if err != nil {
+				return err
+			}
+		} else {
+			f, err := os.Open(fp)
+			if err != nil {
+				return err
+			}
+			dt, err = ioutil.ReadAll(io.NewSectionReader(f, int64(req.Range.Offset), int64(req.Range.Length)))
+			f.Close()
+			if err != nil {
+				return err
+			}
 		}
-		dt, err = ioutil.ReadAll(io.NewSectionReader(f, int64(req.Range.Offset), int64(req.Range.Length)))
-		f.Close()
-		if err != nil {
-			return nil, err
+		return nil
+	})
+	return dt, err
+}
+
+// ReadDir returns the entries of the directory req.Path in ref. The paths of
+// the entries are relative to the directory.
+func ReadDir(ctx context.Context, ref ImmutableRef, req ReadDirRequest) ([]*fsutil.Stat, error) {
+	var wo fsutil.WalkOpt
+	if req.IncludePattern != "" {
+		if _, err := filepath.Match(req.IncludePattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid include pattern %q", req.IncludePattern)
 		}
+		wo.IncludePatterns = []string{req.IncludePattern}
 	}
 
-	if err := lm.Unmount(); err != nil {
+	var entries []*fsutil.Stat
+	err := withMount(ctx, ref, func(root string) error {
+		fp, err := fs.RootPath(root, req.Path)
+		if err != nil {
+			return err
+		}
+		return fsutil.Walk(ctx, fp, &wo, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return errors.Wrapf(err, "failed to walk %s", req.Path)
+			}
+			st, ok := fi.Sys().(*fsutil.Stat)
+			if !ok {
+				return errors.Errorf("invalid fileinfo without stat info: %s", path)
+			}
+			entries = append(entries, st)
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		})
+	})
+	return entries, err
+}
+
+// StatFile returns the stat information of the file at path in ref. Symlinks
+// in the last component of path are not followed.
+func StatFile(ctx context.Context, ref ImmutableRef, path string) (*fsutil.Stat, error) {
+	var st *fsutil.Stat
+	err := withMount(ctx, ref, func(root string) error {
+		dir, base := filepath.Split(filepath.Join("/", path))
+		fp, err := fs.RootPath(root, dir)
+		if err != nil {
+			return err
+		}
+		if base == "" {
+			base = "."
+		} else {
+			fp = filepath.Join(fp, base)
+		}
+		fi, err := os.Lstat(fp)
+		if err != nil {
+			return err
+		}
+		st = &fsutil.Stat{
+			Path:    base,
+			Mode:    uint32(fi.Mode()),
+			ModTime: fi.ModTime().UnixNano(),
+		}
+		setStatOwner(fi, st)
+		if !fi.IsDir() {
+			st.Size_ = fi.Size()
+			if fi.Mode()&os.ModeSymlink != 0 {
+				if st.Linkname, err = os.Readlink(fp); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	lm = nil
-	return dt, err
+	return st, nil
 }
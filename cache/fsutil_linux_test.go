@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/snapshots/native"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDirStatFile(t *testing.T) {
+	t.Parallel()
+	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
+
+	tmpdir, err := ioutil.TempDir("", "cachemanager")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	snapshotter, err := native.NewSnapshotter(filepath.Join(tmpdir, "snapshots"))
+	require.NoError(t, err)
+	cm := getCacheManager(t, tmpdir, snapshotter)
+
+	ref := newTestLayer(ctx, t, cm, nil, func(dir string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "a/b"), 0700))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a/go.mod"), []byte("go"), 0644))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a/go.sum"), []byte("sum"), 0600))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a/b/go.mod"), []byte("nested"), 0644))
+		require.NoError(t, os.Lchown(filepath.Join(dir, "a/go.mod"), 1000, 2000))
+		require.NoError(t, os.Symlink("../a", filepath.Join(dir, "link")))
+	})
+	defer ref.Release(ctx)
+
+	entries, err := ReadDir(ctx, ref, ReadDirRequest{Path: "/"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, "a", entries[0].Path)
+	require.True(t, os.FileMode(entries[0].Mode).IsDir())
+	require.Equal(t, "link", entries[1].Path)
+	require.Equal(t, "../a", entries[1].Linkname)
+
+	// entries of subdirectories are not returned
+	entries, err = ReadDir(ctx, ref, ReadDirRequest{Path: "a"})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+	require.Equal(t, "b", entries[0].Path)
+
+	// symlinks in the path are resolved inside the ref
+	entries, err = ReadDir(ctx, ref, ReadDirRequest{Path: "/link", IncludePattern: "*.sum"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, "go.sum", entries[0].Path)
+	require.Equal(t, int64(3), entries[0].Size_)
+	require.Equal(t, os.FileMode(0600), os.FileMode(entries[0].Mode).Perm())
+
+	_, err = ReadDir(ctx, ref, ReadDirRequest{Path: "/", IncludePattern: "["})
+	require.Error(t, err)
+
+	st, err := StatFile(ctx, ref, "/a/go.mod")
+	require.NoError(t, err)
+	require.Equal(t, "go.mod", st.Path)
+	require.Equal(t, int64(2), st.Size_)
+	require.Equal(t, uint32(1000), st.Uid)
+	require.Equal(t, uint32(2000), st.Gid)
+
+	st, err = StatFile(ctx, ref, "link/b")
+	require.NoError(t, err)
+	require.True(t, os.FileMode(st.Mode).IsDir())
+
+	// the last component is not followed
+	st, err = StatFile(ctx, ref, "link")
+	require.NoError(t, err)
+	require.NotEqual(t, 0, os.FileMode(st.Mode)&os.ModeSymlink)
+
+	st, err = StatFile(ctx, ref, "/")
+	require.NoError(t, err)
+	require.True(t, os.FileMode(st.Mode).IsDir())
+
+	_, err = StatFile(ctx, ref, "/missing")
+	require.Error(t, err)
+	require.True(t, os.IsNotExist(err))
+}
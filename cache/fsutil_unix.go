@@ -0,0 +1,17 @@
+// +build !windows
+
+package cache
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/tonistiigi/fsutil"
+)
+
+func setStatOwner(fi os.FileInfo, st *fsutil.Stat) {
+	if s, ok := fi.Sys().(*syscall.Stat_t); ok {
+		st.Uid = s.Uid
+		st.Gid = s.Gid
+	}
+}
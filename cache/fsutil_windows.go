@@ -0,0 +1,10 @@
+package cache
+
+import (
+	"os"
+
+	"github.com/tonistiigi/fsutil"
+)
+
+func setStatOwner(fi os.FileInfo, st *fsutil.Stat) {
+}
@@ -0,0 +1,367 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/snapshots"
+	"github.com/moby/buildkit/cache/metadata"
+	"github.com/moby/buildkit/identity"
+	"github.com/moby/buildkit/snapshot"
+	"github.com/moby/buildkit/util/contentutil"
+	"github.com/moby/buildkit/util/progress"
+	"github.com/moby/buildkit/util/winlayers"
+	digest "github.com/opencontainers/go-digest"
+	ociidentity "github.com/opencontainers/image-spec/identity"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+const containerdUncompressed = "containerd.io/uncompressed"
+
+// DescHandler fetches the blob of a lazy ref when its contents are needed
+type DescHandler struct {
+	Provider content.Provider
+	// Ref is the image reference the blob was pulled from. Exporters pushing
+	// to the same registry can mount the blob from it.
+	Ref string
+}
+
+// LazyBlob is a layer blob of a lazy ref that is not in the content store
+type LazyBlob struct {
+	Descriptor ocispec.Descriptor
+	*DescHandler
+}
+
+// GetByBlob returns a ref for the layer desc on top of parent. The blob is
+// only fetched and unpacked when the ref is mounted, dh is used to fetch it.
+// desc needs to have the uncompressed digest of the layer in the
+// containerd.io/uncompressed annotation.
+func (cm *cacheManager) GetByBlob(ctx context.Context, desc ocispec.Descriptor, parent ImmutableRef, dh *DescHandler, opts ...RefOption) (ImmutableRef, error) {
+	if cm.ContentStore == nil || cm.Applier == nil {
+		return nil, errors.Errorf("cache manager does not support lazy refs")
+	}
+	diffID, err := digest.Parse(desc.Annotations[containerdUncompressed])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid uncompressed digest for blob %s", desc.Digest)
+	}
+
+	// the ID is the chain ID of the layers so unpacked refs are shared with
+	// pulls that unpack all layers
+	id := diffID
+	var parentID string
+	if parent != nil {
+		parentID = parent.ID()
+		id = ociidentity.ChainID([]digest.Digest{digest.Digest(parentID), diffID})
+	}
+
+	// the manager lock is held from the lookup until the new record is added
+	// so that concurrent calls for the same layer share one record. The locks
+	// of the records are not held while layers are fetched, see unlazy.
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	existing, err := cm.get(ctx, string(id), true, opts...)
+	if err == nil {
+		sr := existing.(*immutableRef)
+		sr.mu.Lock()
+		if sr.descHandler == nil {
+			sr.descHandler = dh
+		}
+		sr.mu.Unlock()
+		return existing, nil
+	}
+	if !IsNotFound(err) {
+		return nil, err
+	}
+
+	var p ImmutableRef
+	if parent != nil {
+		p, err = cm.get(ctx, parentID, false, NoUpdateLastUsed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	md, _ := cm.md.Get(string(id))
+
+	rec := &cacheRecord{
+		mu:          &sync.Mutex{},
+		cm:          cm,
+		refs:        make(map[ref]struct{}),
+		parent:      p,
+		md:          md,
+		descHandler: dh,
+	}
+
+	if err := queueLazyBlob(md, lazyBlob{Desc: desc, DiffID: diffID, Parent: parentID}); err != nil {
+		if p != nil {
+			p.Release(context.TODO())
+		}
+		return nil, err
+	}
+	setSize(md, sizeUnknown)
+	if err := initializeMetadata(rec, opts...); err != nil {
+		if p != nil {
+			p.Release(context.TODO())
+		}
+		return nil, err
+	}
+	if err := md.Commit(); err != nil {
+		if p != nil {
+			p.Release(context.TODO())
+		}
+		return nil, err
+	}
+
+	cm.records[string(id)] = rec
+	return rec.ref(true), nil
+}
+
+// getLazyRecord loads a record whose layer has not been unpacked. The
+// DescHandler of a record is only kept in memory, so a record loaded from disk
+// can only be unpacked if its blob is in the content store. Otherwise its
+// metadata is removed and a later GetByBlob creates the record again with a
+// new DescHandler. Requires manager lock.
+func (cm *cacheManager) getLazyRecord(ctx context.Context, md *metadata.StorageItem, lb *lazyBlob, opts ...RefOption) (cr *cacheRecord, retErr error) {
+	if cm.ContentStore == nil {
+		return nil, errors.Wrapf(errNotFound, "lazy ref %s", md.ID())
+	}
+	if _, err := cm.ContentStore.Info(ctx, lb.Desc.Digest); err != nil {
+		if !errdefs.IsNotFound(err) {
+			return nil, err
+		}
+		if err := cm.md.Clear(md.ID()); err != nil {
+			return nil, err
+		}
+		return nil, errors.Wrapf(errNotFound, "blob %s for lazy ref %s: %v", lb.Desc.Digest, md.ID(), err)
+	}
+
+	var parent ImmutableRef
+	if lb.Parent != "" {
+		var err error
+		parent, err = cm.get(ctx, lb.Parent, false, append(opts, NoUpdateLastUsed)...)
+		if err != nil {
+			if errors.Cause(err) == errNotFound {
+				if err := cm.md.Clear(md.ID()); err != nil {
+					return nil, err
+				}
+			}
+			return nil, err
+		}
+		defer func() {
+			if retErr != nil {
+				parent.Release(context.TODO())
+			}
+		}()
+	}
+
+	rec := &cacheRecord{
+		mu:     &sync.Mutex{},
+		cm:     cm,
+		refs:   make(map[ref]struct{}),
+		parent: parent,
+		md:     md,
+	}
+
+	if getDeleted(md) {
+		if err := rec.remove(ctx, true); err != nil {
+			return nil, err
+		}
+		return nil, errNotFound
+	}
+
+	if err := initializeMetadata(rec, opts...); err != nil {
+		return nil, err
+	}
+
+	cm.records[md.ID()] = rec
+	return rec, nil
+}
+
+// hold ref lock before calling
+func (cr *cacheRecord) isLazy() bool {
+	return getLazyBlob(cr.md) != nil
+}
+
+// unlazy fetches and unpacks the layer of a lazy record and its parents. The
+// layer is fetched and unpacked without holding the lock of the record so
+// that a slow fetch does not block the cache manager. Concurrent calls for the
+// same record share one fetch.
+func (cr *cacheRecord) unlazy(ctx context.Context) error {
+	_, err := cr.cm.unlazyG.Do(ctx, cr.ID(), func(ctx context.Context) (interface{}, error) {
+		cr.mu.Lock()
+		lb := getLazyBlob(cr.md)
+		dh := cr.descHandler
+		windows := GetLayerType(cr) == "windows"
+		cr.mu.Unlock()
+		if lb == nil {
+			return nil, nil
+		}
+
+		if cr.parent != nil {
+			if err := cr.parent.(*immutableRef).unlazy(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := cr.cm.fetchBlob(ctx, lb.Desc, dh, cr.ID()); err != nil {
+			return nil, err
+		}
+
+		if windows {
+			ctx = winlayers.UseWindowsLayerMode(ctx)
+		}
+
+		if _, err := cr.cm.Snapshotter.Stat(ctx, cr.ID()); err != nil {
+			done := oneOffProgress(ctx, "extracting "+lb.Desc.Digest.String())
+			if err := done(cr.applyBlob(ctx, lb)); err != nil {
+				return nil, err
+			}
+		}
+
+		if bm, ok := cr.cm.Snapshotter.(snapshot.Blobmapper); ok {
+			if err := bm.SetBlob(ctx, cr.ID(), lb.DiffID, lb.Desc.Digest); err != nil {
+				return nil, err
+			}
+		}
+
+		cr.mu.Lock()
+		defer cr.mu.Unlock()
+		clearLazyBlob(cr.md)
+		setSize(cr.md, sizeUnknown)
+		return nil, cr.md.Commit()
+	})
+	return err
+}
+
+// applyBlob unpacks the layer of a lazy record to a new snapshot on top of
+// the snapshot of its parent
+func (cr *cacheRecord) applyBlob(ctx context.Context, lb *lazyBlob) (err error) {
+	key := fmt.Sprintf("extract-%s %s", identity.NewID(), cr.ID())
+	labels := map[string]string{
+		containerdUncompressed: lb.DiffID.String(),
+	}
+	if err := cr.cm.Snapshotter.Prepare(ctx, key, lb.Parent, snapshots.WithLabels(labels)); err != nil {
+		return errors.Wrapf(err, "failed to prepare extraction snapshot for %s", cr.ID())
+	}
+	defer func() {
+		if err != nil {
+			cr.cm.Snapshotter.Remove(context.TODO(), key)
+		}
+	}()
+
+	m, err := cr.cm.Snapshotter.Mounts(ctx, key)
+	if err != nil {
+		return err
+	}
+	mounts, err := m.Mount()
+	if err != nil {
+		return err
+	}
+	diff, err := cr.cm.Applier.Apply(ctx, lb.Desc, mounts)
+	m.Release()
+	if err != nil {
+		return errors.Wrapf(err, "failed to extract layer %s", lb.DiffID)
+	}
+	if diff.Digest != lb.DiffID {
+		return errors.Errorf("wrong diff id calculated on extraction %q, expected %q", diff.Digest, lb.DiffID)
+	}
+
+	if err := cr.cm.Snapshotter.Commit(ctx, cr.ID(), key, snapshots.WithLabels(labels)); err != nil {
+		if !errdefs.IsAlreadyExists(err) {
+			return errors.Wrapf(err, "failed to commit snapshot %s", cr.ID())
+		}
+		return cr.cm.Snapshotter.Remove(ctx, key)
+	}
+	return nil
+}
+
+// fetchBlob copies the blob of the lazy record id to the content store if it
+// is not there yet. Concurrent calls for the same blob share one fetch.
+func (cm *cacheManager) fetchBlob(ctx context.Context, desc ocispec.Descriptor, dh *DescHandler, id string) error {
+	_, err := cm.unlazyG.Do(ctx, "blob::"+desc.Digest.String(), func(ctx context.Context) (interface{}, error) {
+		if _, err := cm.ContentStore.Info(ctx, desc.Digest); err == nil {
+			return nil, nil
+		} else if !errdefs.IsNotFound(err) {
+			return nil, err
+		}
+		if dh == nil {
+			return nil, errors.Errorf("no provider for blob %s of lazy ref %s", desc.Digest, id)
+		}
+		done := oneOffProgress(ctx, "pulling "+desc.Digest.String())
+		return nil, done(contentutil.Copy(ctx, cm.ContentStore, dh.Provider, desc))
+	})
+	return err
+}
+
+// LazyBlobs returns the layer blobs of ref and its parents that have not been
+// fetched to the content store
+func LazyBlobs(ctx context.Context, ref ImmutableRef) ([]LazyBlob, error) {
+	var out []LazyBlob
+	for ref != nil {
+		sr, ok := ref.(*immutableRef)
+		if !ok {
+			return nil, errors.Errorf("invalid ref type %T", ref)
+		}
+		sr.mu.Lock()
+		lb := getLazyBlob(sr.md)
+		dh := sr.descHandler
+		sr.mu.Unlock()
+		if lb != nil {
+			if _, err := sr.cm.ContentStore.Info(ctx, lb.Desc.Digest); err != nil {
+				if !errdefs.IsNotFound(err) {
+					return nil, err
+				}
+				if dh == nil {
+					return nil, errors.Errorf("no provider for blob %s of lazy ref %s", lb.Desc.Digest, sr.ID())
+				}
+				out = append([]LazyBlob{{Descriptor: lb.Desc, DescHandler: dh}}, out...)
+			}
+		}
+		ref = sr.parent
+	}
+	return out, nil
+}
+
+// FetchBlobs copies the layer blobs of ref and its parents that have not been
+// fetched to the content store without unpacking them
+func FetchBlobs(ctx context.Context, ref ImmutableRef) error {
+	for ref != nil {
+		sr, ok := ref.(*immutableRef)
+		if !ok {
+			return errors.Errorf("invalid ref type %T", ref)
+		}
+		sr.mu.Lock()
+		lb := getLazyBlob(sr.md)
+		dh := sr.descHandler
+		sr.mu.Unlock()
+		if lb != nil {
+			if err := sr.cm.fetchBlob(ctx, lb.Desc, dh, sr.ID()); err != nil {
+				return err
+			}
+		}
+		ref = sr.parent
+	}
+	return nil
+}
+
+func oneOffProgress(ctx context.Context, id string) func(err error) error {
+	pw, _, _ := progress.FromContext(ctx)
+	now := time.Now()
+	st := progress.Status{
+		Started: &now,
+	}
+	pw.Write(id, st)
+	return func(err error) error {
+		now := time.Now()
+		st.Completed = &now
+		pw.Write(id, st)
+		pw.Close()
+		return err
+	}
+}
@@ -6,12 +6,16 @@ import (
 	"sync"
 	"time"
 
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/diff"
 	"github.com/containerd/containerd/filters"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/moby/buildkit/cache/metadata"
 	"github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/identity"
 	"github.com/moby/buildkit/snapshot"
+	"github.com/moby/buildkit/util/flightcontrol"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
@@ -27,13 +31,19 @@ type ManagerOpt struct {
 	Snapshotter     snapshot.SnapshotterBase
 	MetadataStore   *metadata.Store
 	PruneRefChecker ExternalRefCheckerFunc
+	// ContentStore and Applier are needed for refs created with GetByBlob
+	ContentStore content.Store
+	Applier      diff.Applier
 }
 
 type Accessor interface {
 	Get(ctx context.Context, id string, opts ...RefOption) (ImmutableRef, error)
 	GetFromSnapshotter(ctx context.Context, id string, opts ...RefOption) (ImmutableRef, error)
+	GetByBlob(ctx context.Context, desc ocispec.Descriptor, parent ImmutableRef, dh *DescHandler, opts ...RefOption) (ImmutableRef, error)
 	New(ctx context.Context, s ImmutableRef, opts ...RefOption) (MutableRef, error)
 	GetMutable(ctx context.Context, id string) (MutableRef, error) // Rebase?
+	Merge(ctx context.Context, inputs []ImmutableRef, opts ...RefOption) (ImmutableRef, error)
+	Diff(ctx context.Context, lower, upper ImmutableRef, opts ...RefOption) (ImmutableRef, error)
 }
 
 type Controller interface {
@@ -60,6 +70,7 @@ type cacheManager struct {
 	md *metadata.Store
 
 	muPrune sync.Mutex // make sure parallel prune is not allowed so there will not be inconsistent results
+	unlazyG flightcontrol.Group
 }
 
 func NewManager(opt ManagerOpt) (Manager, error) {
@@ -183,8 +194,18 @@ func (cm *cacheManager) getRecord(ctx context.Context, id string, fromSnapshotte
 
 	info, err := cm.Snapshotter.Stat(ctx, id)
 	if err != nil {
+		if lb := getLazyBlob(md); lb != nil {
+			return cm.getLazyRecord(ctx, md, lb, opts...)
+		}
 		return nil, errors.Wrap(errNotFound, err.Error())
 	}
+	if getLazyBlob(md) != nil {
+		// the layer was unpacked before the metadata was updated
+		clearLazyBlob(md)
+		if err := md.Commit(); err != nil {
+			return nil, err
+		}
+	}
 
 	var parent ImmutableRef
 	if info.Parent != "" {
@@ -241,6 +262,10 @@ func (cm *cacheManager) New(ctx context.Context, s ImmutableRef, opts ...RefOpti
 		if err := parent.Finalize(ctx, true); err != nil {
 			return nil, err
 		}
+		if err := parent.(*immutableRef).unlazy(ctx); err != nil {
+			parent.Release(context.TODO())
+			return nil, err
+		}
 		parentID = parent.ID()
 	}
 
@@ -1,6 +1,9 @@
 package cache
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io/ioutil"
@@ -8,12 +11,19 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/diff/apply"
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/containerd/containerd/snapshots/native"
 	"github.com/moby/buildkit/cache/metadata"
 	"github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/snapshot"
+	digest "github.com/opencontainers/go-digest"
+	ociidentity "github.com/opencontainers/image-spec/identity"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
@@ -369,6 +379,265 @@ func TestLazyCommit(t *testing.T) {
 	require.Equal(t, errNotFound, errors.Cause(err))
 }
 
+func TestGetByBlob(t *testing.T) {
+	t.Parallel()
+	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
+
+	tmpdir, err := ioutil.TempDir("", "cachemanager")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	snapshotter, err := native.NewSnapshotter(filepath.Join(tmpdir, "snapshots"))
+	require.NoError(t, err)
+
+	cs, err := local.NewStore(filepath.Join(tmpdir, "content"))
+	require.NoError(t, err)
+
+	md, err := metadata.NewStore(filepath.Join(tmpdir, "metadata.db"))
+	require.NoError(t, err)
+
+	cm, err := NewManager(ManagerOpt{
+		Snapshotter:   snapshot.FromContainerdSnapshotter(snapshotter),
+		MetadataStore: md,
+		ContentStore:  cs,
+		Applier:       apply.NewFileSystemApplier(cs),
+	})
+	require.NoError(t, err)
+
+	// the registry the layers are fetched from
+	remote, err := local.NewStore(filepath.Join(tmpdir, "remote"))
+	require.NoError(t, err)
+	dh := &DescHandler{Provider: remote}
+
+	desc1 := writeLayer(ctx, t, remote, "foo", "foo0")
+	desc2 := writeLayer(ctx, t, remote, "bar", "bar0")
+	diffID1 := digest.Digest(desc1.Annotations[containerdUncompressed])
+	diffID2 := digest.Digest(desc2.Annotations[containerdUncompressed])
+
+	ref1, err := cm.GetByBlob(ctx, desc1, nil, dh, CachePolicyRetain)
+	require.NoError(t, err)
+	require.Equal(t, string(diffID1), ref1.ID())
+
+	ref2, err := cm.GetByBlob(ctx, desc2, ref1, dh, CachePolicyRetain)
+	require.NoError(t, err)
+	require.Equal(t, string(ociidentity.ChainID([]digest.Digest{diffID1, diffID2})), ref2.ID())
+
+	// nothing is fetched before the refs are mounted
+	_, err = cs.Info(ctx, desc1.Digest)
+	require.True(t, errdefs.IsNotFound(err))
+	size, err := ref2.Size(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), size)
+
+	lazy, err := LazyBlobs(ctx, ref2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(lazy))
+	require.Equal(t, desc1.Digest, lazy[0].Descriptor.Digest)
+	require.Equal(t, desc2.Digest, lazy[1].Descriptor.Digest)
+
+	// getting the same layer again returns the same record
+	ref3, err := cm.GetByBlob(ctx, desc1, nil, dh)
+	require.NoError(t, err)
+	require.Equal(t, ref1.ID(), ref3.ID())
+	require.NoError(t, ref3.Release(ctx))
+
+	m, err := ref2.Mount(ctx, true)
+	require.NoError(t, err)
+
+	lm := snapshot.LocalMounter(m)
+	target, err := lm.Mount()
+	require.NoError(t, err)
+
+	dt, err := ioutil.ReadFile(filepath.Join(target, "foo"))
+	require.NoError(t, err)
+	require.Equal(t, "foo0", string(dt))
+	dt, err = ioutil.ReadFile(filepath.Join(target, "bar"))
+	require.NoError(t, err)
+	require.Equal(t, "bar0", string(dt))
+
+	require.NoError(t, lm.Unmount())
+
+	_, err = cs.Info(ctx, desc1.Digest)
+	require.NoError(t, err)
+	_, _, ok := GetLazyBlob(ref1)
+	require.False(t, ok)
+
+	lazy, err = LazyBlobs(ctx, ref2)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(lazy))
+
+	require.NoError(t, ref2.Release(ctx))
+	require.NoError(t, ref1.Release(ctx))
+}
+
+func TestGetByBlobRestart(t *testing.T) {
+	t.Parallel()
+	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
+
+	tmpdir, err := ioutil.TempDir("", "cachemanager")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	snapshotter, err := native.NewSnapshotter(filepath.Join(tmpdir, "snapshots"))
+	require.NoError(t, err)
+
+	cs, err := local.NewStore(filepath.Join(tmpdir, "content"))
+	require.NoError(t, err)
+
+	newManager := func() (Manager, *metadata.Store) {
+		md, err := metadata.NewStore(filepath.Join(tmpdir, "metadata.db"))
+		require.NoError(t, err)
+		cm, err := NewManager(ManagerOpt{
+			Snapshotter:   snapshot.FromContainerdSnapshotter(snapshotter),
+			MetadataStore: md,
+			ContentStore:  cs,
+			Applier:       apply.NewFileSystemApplier(cs),
+		})
+		require.NoError(t, err)
+		return cm, md
+	}
+
+	remote, err := local.NewStore(filepath.Join(tmpdir, "remote"))
+	require.NoError(t, err)
+
+	desc := writeLayer(ctx, t, remote, "foo", "foo0")
+	id := desc.Annotations[containerdUncompressed]
+
+	cm, _ := newManager()
+	ref, err := cm.GetByBlob(ctx, desc, nil, &DescHandler{Provider: remote}, CachePolicyRetain)
+	require.NoError(t, err)
+	require.NoError(t, ref.Release(ctx))
+	require.NoError(t, cm.Close())
+
+	// the provider of the lazy record is lost with the restart
+	cm, md := newManager()
+	_, ok := md.Get(id)
+	require.False(t, ok)
+	_, err = cm.Get(ctx, id)
+	require.True(t, IsNotFound(err))
+
+	ref, err = cm.GetByBlob(ctx, desc, nil, &DescHandler{Provider: remote})
+	require.NoError(t, err)
+
+	lazy, err := LazyBlobs(ctx, ref)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(lazy))
+	require.NoError(t, FetchBlobs(ctx, ref))
+
+	require.NoError(t, ref.Release(ctx))
+	require.NoError(t, cm.Close())
+}
+
+func TestGetByBlobSlowFetch(t *testing.T) {
+	t.Parallel()
+	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
+
+	tmpdir, err := ioutil.TempDir("", "cachemanager")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	snapshotter, err := native.NewSnapshotter(filepath.Join(tmpdir, "snapshots"))
+	require.NoError(t, err)
+
+	cs, err := local.NewStore(filepath.Join(tmpdir, "content"))
+	require.NoError(t, err)
+
+	md, err := metadata.NewStore(filepath.Join(tmpdir, "metadata.db"))
+	require.NoError(t, err)
+
+	cm, err := NewManager(ManagerOpt{
+		Snapshotter:   snapshot.FromContainerdSnapshotter(snapshotter),
+		MetadataStore: md,
+		ContentStore:  cs,
+		Applier:       apply.NewFileSystemApplier(cs),
+	})
+	require.NoError(t, err)
+
+	remote, err := local.NewStore(filepath.Join(tmpdir, "remote"))
+	require.NoError(t, err)
+	slow := &blockingProvider{Provider: remote, started: make(chan struct{}, 10), release: make(chan struct{})}
+
+	desc1 := writeLayer(ctx, t, remote, "foo", "foo0")
+	desc2 := writeLayer(ctx, t, remote, "bar", "bar0")
+
+	ref1, err := cm.GetByBlob(ctx, desc1, nil, &DescHandler{Provider: slow}, CachePolicyRetain)
+	require.NoError(t, err)
+	defer ref1.Release(context.TODO())
+
+	// two mounts of the same ref share one fetch
+	errCh := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := ref1.Mount(ctx, true)
+			errCh <- err
+		}()
+	}
+	<-slow.started
+
+	// the cache manager and the record are not locked during the fetch
+	ref2, err := cm.Get(ctx, ref1.ID())
+	require.NoError(t, err)
+	require.NoError(t, ref2.Release(ctx))
+
+	ref3, err := cm.GetByBlob(ctx, desc2, nil, &DescHandler{Provider: remote})
+	require.NoError(t, err)
+	_, err = ref3.Size(ctx)
+	require.NoError(t, err)
+	require.NoError(t, ref3.Release(ctx))
+
+	close(slow.release)
+	require.NoError(t, <-errCh)
+	require.NoError(t, <-errCh)
+	require.Equal(t, 0, len(slow.started))
+
+	_, _, ok := GetLazyBlob(ref1)
+	require.False(t, ok)
+}
+
+// blockingProvider blocks reading blobs until release is closed
+type blockingProvider struct {
+	content.Provider
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingProvider) ReaderAt(ctx context.Context, desc ocispec.Descriptor) (content.ReaderAt, error) {
+	p.started <- struct{}{}
+	<-p.release
+	return p.Provider.ReaderAt(ctx, desc)
+}
+
+func writeLayer(ctx context.Context, t *testing.T, cs content.Store, name, data string) ocispec.Descriptor {
+	tarBuf := &bytes.Buffer{}
+	tw := tar.NewWriter(tarBuf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0644,
+		Size:     int64(len(data)),
+		Typeflag: tar.TypeReg,
+	}))
+	_, err := tw.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	_, err = gz.Write(tarBuf.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	desc := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageLayerGzip,
+		Digest:    digest.FromBytes(buf.Bytes()),
+		Size:      int64(buf.Len()),
+		Annotations: map[string]string{
+			containerdUncompressed: digest.FromBytes(tarBuf.Bytes()).String(),
+		},
+	}
+	require.NoError(t, content.WriteBlob(ctx, cs, name, bytes.NewReader(buf.Bytes()), desc))
+	return desc
+}
+
 func getCacheManager(t *testing.T, tmpdir string, snapshotter snapshots.Snapshotter) Manager {
 	md, err := metadata.NewStore(filepath.Join(tmpdir, "metadata.db"))
 	require.NoError(t, err)
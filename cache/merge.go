@@ -0,0 +1,240 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/containerd/continuity/fs"
+	"github.com/moby/buildkit/snapshot"
+	"github.com/pkg/errors"
+)
+
+// Merge returns a reference that contains the layers of all inputs stacked on
+// top of each other in order. Layers are reused as is while they apply on top
+// of the previous result. Other layers are recreated on top of the previous
+// result by applying their changes, hardlinking files from the original
+// snapshot when the snapshotter allows it. A layer that is part of several
+// inputs is only applied at its last occurrence so that later inputs win over
+// the changes of earlier ones. Recreated layers remember their source layer so
+// that they are reused by later merges and can share the source blobs on
+// export.
+func (cm *cacheManager) Merge(ctx context.Context, inputs []ImmutableRef, opts ...RefOption) (ImmutableRef, error) {
+	var layers []ImmutableRef
+	for _, inp := range inputs {
+		if inp == nil {
+			continue
+		}
+		layers = append(layers, layerChain(inp)...)
+	}
+	defer releaseAll(layers)
+
+	last := map[string]int{}
+	for i, l := range layers {
+		last[l.ID()] = i
+	}
+
+	var current ImmutableRef
+	for i, l := range layers {
+		if last[l.ID()] != i {
+			continue
+		}
+		var next ImmutableRef
+		if isParent(current, l) && GetDiffSource(l) == "" {
+			next = l.Clone()
+		} else {
+			var err error
+			next, err = cm.mergeLayer(ctx, current, l, opts...)
+			if err != nil {
+				if current != nil {
+					current.Release(context.TODO())
+				}
+				return nil, err
+			}
+		}
+		if current != nil {
+			current.Release(context.TODO())
+		}
+		current = next
+	}
+
+	return current, nil
+}
+
+// mergeLayer returns a ref with the changes of layer applied on top of base
+func (cm *cacheManager) mergeLayer(ctx context.Context, base, layer ImmutableRef, opts ...RefOption) (ImmutableRef, error) {
+	index := "merge:" + refID(base) + ":" + layer.ID()
+
+	sis, err := cm.md.Search(index)
+	if err != nil {
+		return nil, err
+	}
+	for _, si := range sis {
+		if ref, err := cm.Get(ctx, si.ID()); err == nil {
+			return ref, nil
+		}
+	}
+
+	descr := fmt.Sprintf("merged %s", layer.ID())
+	if d := GetDescription(layer.Metadata()); d != "" {
+		descr = "merged " + d
+	}
+
+	mref, err := cm.New(ctx, base, append([]RefOption{WithDescription(descr)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyLayer(ctx, mref, layer); err != nil {
+		mref.Release(context.TODO())
+		return nil, errors.Wrapf(err, "failed to merge %s", layer.ID())
+	}
+
+	ref, err := mref.Commit(ctx)
+	if err != nil {
+		mref.Release(context.TODO())
+		return nil, err
+	}
+
+	md := ref.Metadata()
+	if err := queueMergeSource(md, index, layer.ID()); err != nil {
+		ref.Release(context.TODO())
+		return nil, err
+	}
+	if err := queueCreatedAt(md, GetCreatedAt(layer.Metadata())); err != nil {
+		ref.Release(context.TODO())
+		return nil, err
+	}
+	if err := md.Commit(); err != nil {
+		ref.Release(context.TODO())
+		return nil, err
+	}
+
+	return ref, nil
+}
+
+func applyLayer(ctx context.Context, mref MutableRef, layer ImmutableRef) error {
+	parent := layer.Parent()
+	if parent != nil {
+		defer parent.Release(context.TODO())
+	}
+	lower, release, err := mountDirOrEmpty(ctx, parent)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	upper, release, err := mountDir(ctx, layer, true)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	dest, release, err := mountDir(ctx, mref, false)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// files deleted by diff layers are not part of their snapshot
+	for _, p := range GetDiffDeletes(layer) {
+		target, err := fs.RootPath(dest, p)
+		if err != nil {
+			return err
+		}
+		if err := os.RemoveAll(target); err != nil {
+			return err
+		}
+	}
+
+	return applyChanges(ctx, lower, upper, dest, applyOpt{})
+}
+
+// mountDirOrEmpty is like mountDir but returns an empty directory for nil
+func mountDirOrEmpty(ctx context.Context, ref ImmutableRef) (string, func() error, error) {
+	if ref != nil {
+		return mountDir(ctx, ref, true)
+	}
+	dir, err := ioutil.TempDir("", "buildkit-merge")
+	if err != nil {
+		return "", nil, err
+	}
+	return dir, func() error {
+		return os.RemoveAll(dir)
+	}, nil
+}
+
+// mountDir returns a local directory for m. Read-only bind mounts are
+// accessed directly so that files can be hardlinked from them.
+func mountDir(ctx context.Context, m Mountable, readonly bool) (string, func() error, error) {
+	mountable, err := m.Mount(ctx, readonly)
+	if err != nil {
+		return "", nil, err
+	}
+	mounts, err := mountable.Mount()
+	if err != nil {
+		mountable.Release()
+		return "", nil, err
+	}
+	if readonly && len(mounts) == 1 && (mounts[0].Type == "bind" || mounts[0].Type == "rbind") {
+		return mounts[0].Source, mountable.Release, nil
+	}
+	lm := snapshot.LocalMounterWithMounts(mounts)
+	dir, err := lm.Mount()
+	if err != nil {
+		mountable.Release()
+		return "", nil, err
+	}
+	return dir, func() error {
+		err := lm.Unmount()
+		if err1 := mountable.Release(); err == nil {
+			err = err1
+		}
+		return err
+	}, nil
+}
+
+// layerChain returns the refs for all layers of ref, starting from the
+// bottom-most one. The returned refs need to be released.
+func layerChain(ref ImmutableRef) []ImmutableRef {
+	var out []ImmutableRef
+	for r := ref.Clone(); r != nil; r = r.Parent() {
+		out = append([]ImmutableRef{r}, out...)
+	}
+	return out
+}
+
+// isParent returns true if parent is the direct parent of ref. A nil parent
+// matches refs without a parent.
+func isParent(parent, ref ImmutableRef) bool {
+	p := ref.Parent()
+	if p == nil {
+		return parent == nil
+	}
+	defer p.Release(context.TODO())
+	return parent != nil && p.ID() == parent.ID()
+}
+
+func refID(ref ImmutableRef) string {
+	if ref == nil {
+		return ""
+	}
+	return ref.ID()
+}
+
+func layerIDs(ref ImmutableRef) []string {
+	layers := layerChain(ref)
+	defer releaseAll(layers)
+	ids := make([]string, 0, len(layers))
+	for _, l := range layers {
+		ids = append(ids, l.ID())
+	}
+	return ids
+}
+
+func releaseAll(refs []ImmutableRef) {
+	for _, r := range refs {
+		r.Release(context.TODO())
+	}
+}
@@ -0,0 +1,193 @@
+package cache
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/containerd/continuity/fs"
+	"github.com/containerd/continuity/sysx"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+type applyOpt struct {
+	// deleted is called with the paths of deleted files instead of removing
+	// them from dest. Their parent directories are still created in dest.
+	deleted func(p string)
+}
+
+type dirInfo struct {
+	path string
+	fi   os.FileInfo
+}
+
+// applyChanges applies the changes between lower and upper to dest
+func applyChanges(ctx context.Context, lower, upper, dest string, opt applyOpt) error {
+	var dirs []dirInfo
+
+	if err := fs.Changes(ctx, lower, upper, func(kind fs.ChangeKind, p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		parent, err := fs.RootPath(dest, filepath.Dir(p))
+		if err != nil {
+			return err
+		}
+		base := filepath.Base(p)
+		target := filepath.Join(parent, base)
+
+		if kind == fs.ChangeKindDelete && opt.deleted == nil {
+			return os.RemoveAll(target)
+		}
+
+		if err := ensureParents(dest, upper, filepath.Dir(p), &dirs); err != nil {
+			return err
+		}
+
+		if kind == fs.ChangeKindDelete {
+			opt.deleted(p)
+			return nil
+		}
+
+		src := filepath.Join(upper, p)
+
+		if fi.IsDir() {
+			if st, err := os.Lstat(target); err != nil || !st.IsDir() {
+				if err := os.RemoveAll(target); err != nil {
+					return err
+				}
+				if err := os.Mkdir(target, fi.Mode()&os.ModePerm); err != nil {
+					return err
+				}
+			}
+			dirs = append(dirs, dirInfo{path: target, fi: fi})
+			return copyMetadata(src, fi, target, false)
+		}
+
+		if err := os.RemoveAll(target); err != nil {
+			return err
+		}
+
+		switch mode := fi.Mode(); {
+		case mode.IsRegular():
+			if err := os.Link(src, target); err == nil {
+				return nil
+			}
+			if err := copyFileContent(src, target); err != nil {
+				return errors.Wrapf(err, "failed to copy %s", p)
+			}
+		case mode&os.ModeSymlink != 0:
+			link, err := os.Readlink(src)
+			if err != nil {
+				return err
+			}
+			if err := os.Symlink(link, target); err != nil {
+				return err
+			}
+		case mode&(os.ModeDevice|os.ModeNamedPipe) != 0:
+			st := fi.Sys().(*syscall.Stat_t)
+			if err := unix.Mknod(target, st.Mode, int(st.Rdev)); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+		return copyMetadata(src, fi, target, true)
+	}); err != nil {
+		return err
+	}
+
+	// directory times are set last as applying their contents changes them
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := setTimes(dirs[i].fi, dirs[i].path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureParents creates the directories of p that are missing in dest with
+// the metadata they have in upper. Changes only contain the directories that
+// were modified so their parents may not exist in dest yet.
+func ensureParents(dest, upper, p string, dirs *[]dirInfo) error {
+	if p == "/" || p == "." {
+		return nil
+	}
+	if err := ensureParents(dest, upper, filepath.Dir(p), dirs); err != nil {
+		return err
+	}
+	target, err := fs.RootPath(dest, p)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Lstat(target); err == nil {
+		return nil
+	}
+	src := filepath.Join(upper, p)
+	fi, err := os.Lstat(src)
+	if err != nil {
+		return err
+	}
+	if err := os.Mkdir(target, fi.Mode()&os.ModePerm); err != nil {
+		return err
+	}
+	*dirs = append(*dirs, dirInfo{path: target, fi: fi})
+	return copyMetadata(src, fi, target, false)
+}
+
+func copyFileContent(src, target string) error {
+	s, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	t, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer t.Close()
+	_, err = io.Copy(t, s)
+	return err
+}
+
+func copyMetadata(src string, fi os.FileInfo, target string, times bool) error {
+	st := fi.Sys().(*syscall.Stat_t)
+	if err := os.Lchown(target, int(st.Uid), int(st.Gid)); err != nil {
+		return errors.Wrapf(err, "failed to chown %s", target)
+	}
+	if fi.Mode()&os.ModeSymlink == 0 {
+		if err := os.Chmod(target, fi.Mode()&(os.ModePerm|os.ModeSetuid|os.ModeSetgid|os.ModeSticky)); err != nil {
+			return errors.Wrapf(err, "failed to chmod %s", target)
+		}
+	}
+	xattrKeys, err := sysx.LListxattr(src)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list xattrs on %s", src)
+	}
+	for _, xattr := range xattrKeys {
+		data, err := sysx.LGetxattr(src, xattr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get xattr %q on %s", xattr, src)
+		}
+		if err := sysx.LSetxattr(target, xattr, data, 0); err != nil {
+			return errors.Wrapf(err, "failed to set xattr %q on %s", xattr, target)
+		}
+	}
+	if times {
+		return setTimes(fi, target)
+	}
+	return nil
+}
+
+func setTimes(fi os.FileInfo, target string) error {
+	st := fi.Sys().(*syscall.Stat_t)
+	timespec := []unix.Timespec{unix.Timespec(st.Atim), unix.Timespec(st.Mtim)}
+	if err := unix.UtimesNanoAt(unix.AT_FDCWD, target, timespec, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		return errors.Wrapf(err, "failed to utime %s", target)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package cache
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/snapshots/native"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
+
+	tmpdir, err := ioutil.TempDir("", "cachemanager")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	snapshotter, err := native.NewSnapshotter(filepath.Join(tmpdir, "snapshots"))
+	require.NoError(t, err)
+	cm := getCacheManager(t, tmpdir, snapshotter)
+
+	a := newTestLayer(ctx, t, cm, nil, func(dir string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a"), []byte("a"), 0644))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "shared"), []byte("a"), 0644))
+	})
+	defer a.Release(ctx)
+
+	b1 := newTestLayer(ctx, t, cm, nil, func(dir string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "b"), []byte("b"), 0644))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "shared"), []byte("b"), 0644))
+	})
+	defer b1.Release(ctx)
+
+	b2 := newTestLayer(ctx, t, cm, b1, func(dir string) {
+		require.NoError(t, os.Remove(filepath.Join(dir, "b")))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "c"), []byte("c"), 0644))
+	})
+	defer b2.Release(ctx)
+
+	merged, err := cm.Merge(ctx, []ImmutableRef{a, nil, b2})
+	require.NoError(t, err)
+	defer merged.Release(ctx)
+
+	require.Equal(t, 3, len(layerIDs(merged)))
+	require.Equal(t, a.ID(), layerIDs(merged)[0])
+
+	dir, release, err := mountDir(ctx, merged, true)
+	require.NoError(t, err)
+
+	requireFileContent(t, filepath.Join(dir, "a"), "a")
+	requireFileContent(t, filepath.Join(dir, "shared"), "b")
+	requireFileContent(t, filepath.Join(dir, "c"), "c")
+	_, err = os.Stat(filepath.Join(dir, "b"))
+	require.True(t, os.IsNotExist(err))
+
+	// files are hardlinked from the source layer
+	fi1, err := os.Stat(filepath.Join(dir, "c"))
+	require.NoError(t, err)
+	srcDir, srcRelease, err := mountDir(ctx, b2, true)
+	require.NoError(t, err)
+	fi2, err := os.Stat(filepath.Join(srcDir, "c"))
+	require.NoError(t, err)
+	require.True(t, os.SameFile(fi1, fi2))
+	require.NoError(t, srcRelease())
+	require.NoError(t, release())
+
+	require.Equal(t, b2.ID(), GetMergeSource(merged))
+
+	// merging the same inputs again reuses the merged layers
+	merged2, err := cm.Merge(ctx, []ImmutableRef{a, b2})
+	require.NoError(t, err)
+	require.Equal(t, merged.ID(), merged2.ID())
+	require.NoError(t, merged2.Release(ctx))
+
+	// a single input is returned as is
+	single, err := cm.Merge(ctx, []ImmutableRef{a})
+	require.NoError(t, err)
+	require.Equal(t, a.ID(), single.ID())
+	require.NoError(t, single.Release(ctx))
+}
+
+func TestMergeLaterInputWins(t *testing.T) {
+	t.Parallel()
+	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
+
+	tmpdir, err := ioutil.TempDir("", "cachemanager")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	snapshotter, err := native.NewSnapshotter(filepath.Join(tmpdir, "snapshots"))
+	require.NoError(t, err)
+	cm := getCacheManager(t, tmpdir, snapshotter)
+
+	base := newTestLayer(ctx, t, cm, nil, func(dir string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "shared"), []byte("base"), 0644))
+	})
+	defer base.Release(ctx)
+
+	x := newTestLayer(ctx, t, cm, base, func(dir string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "shared"), []byte("x"), 0644))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "x"), []byte("x"), 0644))
+	})
+	defer x.Release(ctx)
+
+	// base is shared by both inputs but the second input overrides x
+	merged, err := cm.Merge(ctx, []ImmutableRef{x, base})
+	require.NoError(t, err)
+	defer merged.Release(ctx)
+
+	dir, release, err := mountDir(ctx, merged, true)
+	require.NoError(t, err)
+	defer release()
+
+	requireFileContent(t, filepath.Join(dir, "shared"), "base")
+	requireFileContent(t, filepath.Join(dir, "x"), "x")
+}
+
+func newTestLayer(ctx context.Context, t *testing.T, cm Manager, parent ImmutableRef, fn func(string)) ImmutableRef {
+	active, err := cm.New(ctx, parent)
+	require.NoError(t, err)
+
+	dir, release, err := mountDir(ctx, active, false)
+	require.NoError(t, err)
+	fn(dir)
+	require.NoError(t, release())
+
+	ref, err := active.Commit(ctx)
+	require.NoError(t, err)
+	return ref
+}
+
+func requireFileContent(t *testing.T, p, content string) {
+	dt, err := ioutil.ReadFile(p)
+	require.NoError(t, err)
+	require.Equal(t, content, string(dt))
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
+
+	tmpdir, err := ioutil.TempDir("", "cachemanager")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	snapshotter, err := native.NewSnapshotter(filepath.Join(tmpdir, "snapshots"))
+	require.NoError(t, err)
+	cm := getCacheManager(t, tmpdir, snapshotter)
+
+	lower := newTestLayer(ctx, t, cm, nil, func(dir string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a"), []byte("a"), 0644))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "del"), []byte("del"), 0644))
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "dir/sub"), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "dir/sub/x"), []byte("x"), 0644))
+	})
+	defer lower.Release(ctx)
+
+	upper := newTestLayer(ctx, t, cm, lower, func(dir string) {
+		require.NoError(t, os.Remove(filepath.Join(dir, "del")))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "dir/sub/x"), []byte("x2"), 0644))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "new"), []byte("new"), 0644))
+	})
+	defer upper.Release(ctx)
+
+	diff, err := cm.Diff(ctx, lower, upper)
+	require.NoError(t, err)
+	defer diff.Release(ctx)
+
+	require.Equal(t, 1, len(layerIDs(diff)))
+	require.Equal(t, upper.ID(), GetDiffSource(diff))
+	require.Equal(t, []string{"/del"}, GetDiffDeletes(diff))
+
+	dir, release, err := mountDir(ctx, diff, true)
+	require.NoError(t, err)
+	requireFileContent(t, filepath.Join(dir, "dir/sub/x"), "x2")
+	requireFileContent(t, filepath.Join(dir, "new"), "new")
+	_, err = os.Stat(filepath.Join(dir, ".wh.del"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "del"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "a"))
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, release())
+
+	diff2, err := cm.Diff(ctx, lower, upper)
+	require.NoError(t, err)
+	require.Equal(t, diff.ID(), diff2.ID())
+	require.NoError(t, diff2.Release(ctx))
+
+	// the diff can be applied on top of another base
+	base := newTestLayer(ctx, t, cm, nil, func(dir string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "del"), []byte("del"), 0644))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "z"), []byte("z"), 0644))
+	})
+	defer base.Release(ctx)
+
+	merged, err := cm.Merge(ctx, []ImmutableRef{base, diff})
+	require.NoError(t, err)
+	defer merged.Release(ctx)
+
+	dir, release, err = mountDir(ctx, merged, true)
+	require.NoError(t, err)
+	requireFileContent(t, filepath.Join(dir, "z"), "z")
+	requireFileContent(t, filepath.Join(dir, "dir/sub/x"), "x2")
+	requireFileContent(t, filepath.Join(dir, "new"), "new")
+	_, err = os.Stat(filepath.Join(dir, "del"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, ".wh.del"))
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, release())
+}
@@ -0,0 +1,17 @@
+// +build !linux
+
+package cache
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type applyOpt struct {
+	deleted func(p string)
+}
+
+func applyChanges(ctx context.Context, lower, upper, dest string, opt applyOpt) error {
+	return errors.New("merging snapshots is not supported on this platform")
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/moby/buildkit/cache/metadata"
 	"github.com/moby/buildkit/client"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 	bolt "go.etcd.io/bbolt"
 )
@@ -19,6 +21,10 @@ const keyLastUsedAt = "cache.lastUsedAt"
 const keyUsageCount = "cache.usageCount"
 const keyLayerType = "cache.layerType"
 const keyRecordType = "cache.recordType"
+const keyMergeSource = "cache.mergeSource"
+const keyDiffSource = "cache.diffSource"
+const keyDiffDeletes = "cache.diffDeletes"
+const keyLazyBlob = "cache.lazyBlob"
 
 const keyDeleted = "cache.deleted"
 
@@ -260,3 +266,136 @@ func queueRecordType(si *metadata.StorageItem, value client.UsageRecordType) err
 	})
 	return nil
 }
+
+func queueMergeSource(si *metadata.StorageItem, index, source string) error {
+	v, err := metadata.NewValue(source)
+	if err != nil {
+		return errors.Wrap(err, "failed to create mergeSource value")
+	}
+	v.Index = index
+	si.Queue(func(b *bolt.Bucket) error {
+		return si.SetValue(b, keyMergeSource, v)
+	})
+	return nil
+}
+
+// GetMergeSource returns the ID of the record whose changes were applied to
+// create a merged layer. Merged layers have the same diff as their source.
+func GetMergeSource(m withMetadata) string {
+	v := m.Metadata().Get(keyMergeSource)
+	if v == nil {
+		return ""
+	}
+	var str string
+	if err := v.Unmarshal(&str); err != nil {
+		return ""
+	}
+	return str
+}
+
+func queueDiffSource(si *metadata.StorageItem, index, source string) error {
+	v, err := metadata.NewValue(source)
+	if err != nil {
+		return errors.Wrap(err, "failed to create diffSource value")
+	}
+	v.Index = index
+	si.Queue(func(b *bolt.Bucket) error {
+		return si.SetValue(b, keyDiffSource, v)
+	})
+	return nil
+}
+
+// GetDiffSource returns the ID of the record a diff layer was created from.
+// The files deleted by diff layers are returned by GetDiffDeletes.
+func GetDiffSource(m withMetadata) string {
+	v := m.Metadata().Get(keyDiffSource)
+	if v == nil {
+		return ""
+	}
+	var str string
+	if err := v.Unmarshal(&str); err != nil {
+		return ""
+	}
+	return str
+}
+
+func queueDiffDeletes(si *metadata.StorageItem, deleted []string) error {
+	if len(deleted) == 0 {
+		return nil
+	}
+	v, err := metadata.NewValue(deleted)
+	if err != nil {
+		return errors.Wrap(err, "failed to create diffDeletes value")
+	}
+	si.Queue(func(b *bolt.Bucket) error {
+		return si.SetValue(b, keyDiffDeletes, v)
+	})
+	return nil
+}
+
+// GetDiffDeletes returns the paths that were deleted in the changes of a diff
+// layer
+func GetDiffDeletes(m withMetadata) []string {
+	v := m.Metadata().Get(keyDiffDeletes)
+	if v == nil {
+		return nil
+	}
+	var deleted []string
+	if err := v.Unmarshal(&deleted); err != nil {
+		return nil
+	}
+	return deleted
+}
+
+// lazyBlob is the layer of a record whose contents have not been unpacked
+type lazyBlob struct {
+	Desc   ocispec.Descriptor
+	DiffID digest.Digest
+	Parent string
+}
+
+// lazyBlobIndex is the same index the blob mapping uses for the blobs of
+// snapshots so blobs are not deleted while lazy records use them
+func lazyBlobIndex(blob digest.Digest) string {
+	return "blobmap::" + blob.String()
+}
+
+func queueLazyBlob(si *metadata.StorageItem, lb lazyBlob) error {
+	v, err := metadata.NewValue(lb)
+	if err != nil {
+		return errors.Wrap(err, "failed to create lazyBlob value")
+	}
+	v.Index = lazyBlobIndex(lb.Desc.Digest)
+	si.Queue(func(b *bolt.Bucket) error {
+		return si.SetValue(b, keyLazyBlob, v)
+	})
+	return nil
+}
+
+func clearLazyBlob(si *metadata.StorageItem) {
+	si.Queue(func(b *bolt.Bucket) error {
+		return si.SetValue(b, keyLazyBlob, nil)
+	})
+}
+
+func getLazyBlob(si *metadata.StorageItem) *lazyBlob {
+	v := si.Get(keyLazyBlob)
+	if v == nil {
+		return nil
+	}
+	var lb lazyBlob
+	if err := v.Unmarshal(&lb); err != nil {
+		return nil
+	}
+	return &lb
+}
+
+// GetLazyBlob returns the layer blob and its uncompressed digest for a ref
+// that was created with GetByBlob and has not been unpacked yet
+func GetLazyBlob(m withMetadata) (ocispec.Descriptor, digest.Digest, bool) {
+	lb := getLazyBlob(m.Metadata())
+	if lb == nil {
+		return ocispec.Descriptor{}, "", false
+	}
+	return lb.Desc, lb.DiffID, true
+}
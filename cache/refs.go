@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/mount"
 	"github.com/moby/buildkit/cache/metadata"
 	"github.com/moby/buildkit/identity"
@@ -59,6 +60,9 @@ type cacheRecord struct {
 
 	sizeG flightcontrol.Group
 
+	// descHandler fetches the blob of a lazy record
+	descHandler *DescHandler
+
 	// these are filled if multiple refs point to same data
 	equalMutable   *mutableRef
 	equalImmutable *immutableRef
@@ -92,6 +96,11 @@ func (cr *cacheRecord) Size(ctx context.Context) (int64, error) {
 			cr.mu.Unlock()
 			return s, nil
 		}
+		if cr.isLazy() {
+			// nothing has been unpacked yet
+			cr.mu.Unlock()
+			return int64(0), nil
+		}
 		driverID := cr.ID()
 		if cr.equalMutable != nil {
 			driverID = cr.equalMutable.ID()
@@ -134,6 +143,15 @@ func (cr *cacheRecord) parentRef(hidden bool) ImmutableRef {
 }
 
 func (cr *cacheRecord) Mount(ctx context.Context, readonly bool) (snapshot.Mountable, error) {
+	cr.mu.Lock()
+	lazy := !cr.mutable && cr.isLazy()
+	cr.mu.Unlock()
+	if lazy {
+		if err := cr.unlazy(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	cr.mu.Lock()
 	defer cr.mu.Unlock()
 
@@ -180,7 +198,10 @@ func (cr *cacheRecord) remove(ctx context.Context, removeSnapshot bool) error {
 		}
 	}
 	if removeSnapshot {
-		if err := cr.cm.Snapshotter.Remove(ctx, cr.ID()); err != nil {
+		// the snapshot of a lazy record does not exist until its layer is
+		// unpacked. Its blob is left to the garbage collection of the content
+		// store, which may be shared with the images of the worker.
+		if err := cr.cm.Snapshotter.Remove(ctx, cr.ID()); err != nil && !(cr.isLazy() && errdefs.IsNotFound(err)) {
 			return err
 		}
 	}
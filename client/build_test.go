@@ -1,16 +1,20 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/moby/buildkit/client/llb"
 	"github.com/moby/buildkit/frontend/gateway/client"
 	gatewayapi "github.com/moby/buildkit/frontend/gateway/pb"
 	"github.com/moby/buildkit/identity"
+	"github.com/moby/buildkit/solver/pb"
 	"github.com/moby/buildkit/util/testutil/integration"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -21,6 +25,10 @@ func TestClientGatewayIntegration(t *testing.T) {
 		testClientGatewaySolve,
 		testClientGatewayFailedSolve,
 		testClientGatewayEmptySolve,
+		testClientGatewayReadDirStatFile,
+		testClientGatewayContainerExec,
+		testClientGatewayContainerEntitlements,
+		testClientGatewayWarnings,
 		testNoBuildID,
 		testUnknownBuildID,
 	}, integration.WithMirroredImages(integration.OfficialImages("busybox:latest")))
@@ -101,6 +109,305 @@ func testClientGatewaySolve(t *testing.T, sb integration.Sandbox) {
 	checkAllReleasable(t, c, sb, true)
 }
 
+func testClientGatewayReadDirStatFile(t *testing.T, sb integration.Sandbox) {
+	t.Parallel()
+	requiresLinux(t)
+
+	ctx := context.TODO()
+
+	c, err := New(ctx, sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	b := func(ctx context.Context, c client.Client) (*client.Result, error) {
+		run := llb.Image("busybox:latest").Run(
+			llb.Args([]string{"/bin/sh", "-ec", `mkdir -p /out/a/b /out/c && echo -n go > /out/a/go.mod && echo -n sum > /out/a/go.sum && chown 1000:2000 /out/a/go.mod && ln -s go.mod /out/a/link`}),
+		)
+		st := run.AddMount("/out", llb.Scratch())
+
+		def, err := st.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal state")
+		}
+
+		r, err := c.Solve(ctx, client.SolveRequest{
+			Definition: def.ToPB(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to solve")
+		}
+
+		entries, err := r.Ref.ReadDir(ctx, client.ReadDirRequest{
+			Path: "/",
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read dir")
+		}
+		if len(entries) != 2 || entries[0].Path != "a" || entries[1].Path != "c" {
+			return nil, errors.Errorf("unexpected entries %+v", entries)
+		}
+		if !os.FileMode(entries[0].Mode).IsDir() {
+			return nil, errors.Errorf("expected directory, got mode %o", entries[0].Mode)
+		}
+
+		entries, err = r.Ref.ReadDir(ctx, client.ReadDirRequest{
+			Path:           "/a",
+			IncludePattern: "go.*",
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read dir with pattern")
+		}
+		if len(entries) != 2 || entries[0].Path != "go.mod" || entries[1].Path != "go.sum" {
+			return nil, errors.Errorf("unexpected entries %+v", entries)
+		}
+
+		st2, err := r.Ref.StatFile(ctx, client.StatRequest{
+			Path: "/a/go.mod",
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to stat file")
+		}
+		if st2.Path != "go.mod" || st2.Size_ != 2 || st2.Uid != 1000 || st2.Gid != 2000 {
+			return nil, errors.Errorf("unexpected stat %+v", st2)
+		}
+
+		st2, err = r.Ref.StatFile(ctx, client.StatRequest{
+			Path: "/a/link",
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to stat link")
+		}
+		if os.FileMode(st2.Mode)&os.ModeSymlink == 0 || st2.Linkname != "go.mod" {
+			return nil, errors.Errorf("unexpected stat %+v", st2)
+		}
+
+		if _, err := r.Ref.StatFile(ctx, client.StatRequest{Path: "/missing"}); err == nil {
+			return nil, errors.Errorf("expected error for missing file")
+		}
+		return r, nil
+	}
+
+	_, err = c.Build(ctx, SolveOpt{}, "", b, nil)
+	require.NoError(t, err)
+
+	checkAllReleasable(t, c, sb, true)
+}
+
+func testClientGatewayWarnings(t *testing.T, sb integration.Sandbox) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	c, err := New(ctx, sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	sourceInfo := &pb.SourceInfo{
+		Filename: "build.txt",
+		Data:     []byte("first\nsecond\n"),
+	}
+
+	b := func(ctx context.Context, c client.Client) (*client.Result, error) {
+		err := c.Warn(ctx, "", "some warning", client.WarnOpts{
+			Detail:     [][]byte{[]byte("some detail")},
+			SourceInfo: sourceInfo,
+			Range:      []*pb.Range{{Start: pb.Position{Line: 2}, End: pb.Position{Line: 2}}},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to warn")
+		}
+		return client.NewResult(), nil
+	}
+
+	statusChan := make(chan *SolveStatus)
+	var statusWarnings []*VertexWarning
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for s := range statusChan {
+			statusWarnings = append(statusWarnings, s.Warnings...)
+		}
+	}()
+
+	resp, err := c.Build(ctx, SolveOpt{}, "", b, statusChan)
+	require.NoError(t, err)
+	<-done
+
+	for _, ws := range [][]*VertexWarning{resp.Warnings, statusWarnings} {
+		require.Equal(t, 1, len(ws))
+		w := ws[0]
+		require.Equal(t, client.WarnLevelWarning, w.Level)
+		require.Equal(t, "some warning", string(w.Short))
+		require.Equal(t, [][]byte{[]byte("some detail")}, w.Detail)
+		require.NotNil(t, w.SourceInfo)
+		require.Equal(t, "build.txt", w.SourceInfo.Filename)
+		require.Equal(t, 1, len(w.Range))
+		require.Equal(t, int32(2), w.Range[0].Start.Line)
+	}
+}
+
+func testClientGatewayContainerExec(t *testing.T, sb integration.Sandbox) {
+	t.Parallel()
+	requiresLinux(t)
+
+	ctx := context.TODO()
+
+	c, err := New(ctx, sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	b := func(ctx context.Context, c client.Client) (*client.Result, error) {
+		def, err := llb.Image("busybox:latest").Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal state")
+		}
+
+		r, err := c.Solve(ctx, client.SolveRequest{
+			Definition: def.ToPB(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to solve")
+		}
+
+		ctr, err := c.NewContainer(ctx, client.NewContainerRequest{
+			Mounts: []client.Mount{
+				{Dest: "/", MountType: pb.MountType_BIND, Ref: r.Ref},
+				{Dest: "/tmp", MountType: pb.MountType_TMPFS},
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create container")
+		}
+		defer ctr.Release(ctx)
+
+		stdout := &bytes.Buffer{}
+		p, err := ctr.Start(ctx, client.StartRequest{
+			Args:   []string{"sh", "-c", "cat > /foo && echo -n $FOO $(pwd) && cat /foo"},
+			Env:    []string{"FOO=bar"},
+			Cwd:    "/tmp",
+			Stdin:  ioutil.NopCloser(strings.NewReader("from stdin")),
+			Stdout: nopWriteCloser{stdout},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to start process")
+		}
+		if err := p.Wait(); err != nil {
+			return nil, errors.Wrap(err, "process failed")
+		}
+		if stdout.String() != "bar /tmpfrom stdin" {
+			return nil, errors.Errorf("unexpected output %q", stdout.String())
+		}
+
+		// changes to the root are seen by the next process
+		stdout.Reset()
+		p, err = ctr.Start(ctx, client.StartRequest{
+			Args:   []string{"cat", "/foo"},
+			Stdout: nopWriteCloser{stdout},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to start process")
+		}
+		if err := p.Wait(); err != nil {
+			return nil, errors.Wrap(err, "process failed")
+		}
+		if stdout.String() != "from stdin" {
+			return nil, errors.Errorf("unexpected output %q", stdout.String())
+		}
+
+		// resizing a process without a terminal does not block its stdin
+		stdinR, stdinW := io.Pipe()
+		stdout.Reset()
+		p, err = ctr.Start(ctx, client.StartRequest{
+			Args:   []string{"cat"},
+			Stdin:  stdinR,
+			Stdout: nopWriteCloser{stdout},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to start process")
+		}
+		for i := 0; i < 3; i++ {
+			if err := p.Resize(ctx, client.WinSize{Rows: 40, Cols: 80 + uint32(i)}); err != nil {
+				return nil, errors.Wrap(err, "failed to resize process")
+			}
+		}
+		go func() {
+			stdinW.Write([]byte("after resize"))
+			stdinW.Close()
+		}()
+		if err := p.Wait(); err != nil {
+			return nil, errors.Wrap(err, "process failed")
+		}
+		if stdout.String() != "after resize" {
+			return nil, errors.Errorf("unexpected output %q", stdout.String())
+		}
+
+		p, err = ctr.Start(ctx, client.StartRequest{
+			Args: []string{"sh", "-c", "exit 3"},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to start process")
+		}
+		if err := p.Wait(); err == nil {
+			return nil, errors.Errorf("expected process to fail")
+		}
+
+		// the solved ref is not changed by the container
+		if _, err := r.Ref.StatFile(ctx, client.StatRequest{Path: "/foo"}); err == nil {
+			return nil, errors.Errorf("expected /foo to not exist in the solved ref")
+		}
+		return nil, nil
+	}
+
+	_, err = c.Build(ctx, SolveOpt{}, "", b, nil)
+	require.NoError(t, err)
+
+	checkAllReleasable(t, c, sb, true)
+}
+
+func testClientGatewayContainerEntitlements(t *testing.T, sb integration.Sandbox) {
+	t.Parallel()
+	requiresLinux(t)
+
+	ctx := context.TODO()
+
+	c, err := New(ctx, sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	b := func(ctx context.Context, c client.Client) (*client.Result, error) {
+		def, err := llb.Image("busybox:latest").Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal state")
+		}
+
+		r, err := c.Solve(ctx, client.SolveRequest{
+			Definition: def.ToPB(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to solve")
+		}
+
+		ctr, err := c.NewContainer(ctx, client.NewContainerRequest{
+			Mounts: []client.Mount{
+				{Dest: "/", MountType: pb.MountType_BIND, Ref: r.Ref},
+			},
+			NetMode: pb.NetMode_HOST,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ctr.Release(ctx)
+		return nil, nil
+	}
+
+	// host networking is not allowed without the network.host entitlement
+	_, err = c.Build(ctx, SolveOpt{}, "", b, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "network.host is not allowed")
+
+	checkAllReleasable(t, c, sb, true)
+}
+
 func testClientGatewayFailedSolve(t *testing.T, sb integration.Sandbox) {
 	t.Parallel()
 	requiresLinux(t)
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	controlapi "github.com/moby/buildkit/api/services/control"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+// CacheExplanation describes how the cache was checked for a vertex
+type CacheExplanation struct {
+	Vertex digest.Digest
+	Name   string
+	Output int
+	Cached bool
+	Reason string
+	Inputs []CacheExplanationInput
+}
+
+// CacheExplanationInput contains the cache keys that were queried for an
+// input of a vertex
+type CacheExplanationInput struct {
+	Keys    []digest.Digest
+	Changed bool
+}
+
+// CacheExplain returns why the vertexes of a recent build did or did not
+// match the cache. ref is the reference the build was started with.
+func (c *Client) CacheExplain(ctx context.Context, ref string) ([]*CacheExplanation, error) {
+	resp, err := c.controlClient().CacheExplain(ctx, &controlapi.CacheExplainRequest{Ref: ref})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to explain cache")
+	}
+
+	var out []*CacheExplanation
+	for _, e := range resp.Explanations {
+		ex := &CacheExplanation{
+			Vertex: e.Vertex,
+			Name:   e.Name,
+			Output: int(e.Output),
+			Cached: e.Cached,
+			Reason: e.Reason,
+		}
+		for _, inp := range e.Inputs {
+			ex.Inputs = append(ex.Inputs, CacheExplanationInput{
+				Keys:    inp.Keys,
+				Changed: inp.Changed,
+			})
+		}
+		out = append(out, ex)
+	}
+	return out, nil
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"archive/tar"
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/snapshots"
@@ -30,8 +32,12 @@ import (
 	gateway "github.com/moby/buildkit/frontend/gateway/client"
 	"github.com/moby/buildkit/identity"
 	"github.com/moby/buildkit/session"
+	sessioncontent "github.com/moby/buildkit/session/content"
 	"github.com/moby/buildkit/session/secrets/secretsprovider"
 	"github.com/moby/buildkit/session/sshforward/sshprovider"
+	"github.com/moby/buildkit/solver/pb"
+	spb "github.com/moby/buildkit/sourcepolicy/pb"
+	"github.com/moby/buildkit/util/entitlements"
 	"github.com/moby/buildkit/util/testutil"
 	"github.com/moby/buildkit/util/testutil/httpserver"
 	"github.com/moby/buildkit/util/testutil/integration"
@@ -58,6 +64,11 @@ func TestClientIntegration(t *testing.T) {
 		testResolveAndHosts,
 		testUser,
 		testOCIExporter,
+		testProvenance,
+		testSBOM,
+		testSourcePolicy,
+		testDebugShell,
+		testOCILayoutSource,
 		testWhiteoutParentDir,
 		testFrontendImageNaming,
 		testDuplicateWhiteouts,
@@ -77,9 +88,11 @@ func TestClientIntegration(t *testing.T) {
 		testSecretMounts,
 		testExtraHosts,
 		testNetworkMode,
+		testSecurityModeErrors,
 		testFrontendMetadataReturn,
 		testSSHMount,
 		testStdinClosed,
+		testDiffOpWhiteouts,
 	},
 		integration.WithMirroredImages(integration.OfficialImages("busybox:latest", "alpine:latest")),
 	)
@@ -309,6 +322,31 @@ func testNetworkMode(t *testing.T, sb integration.Sandbox) {
 	require.Contains(t, err.Error(), "network.host is not allowed")
 }
 
+func testSecurityModeErrors(t *testing.T, sb integration.Sandbox) {
+	t.Parallel()
+
+	c, err := New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	st := llb.Image("busybox:latest").
+		Run(llb.Shlex(`sh -c 'echo sandbox'`), llb.Security(llb.SecurityModeInsecure))
+
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	_, err = c.Solve(context.TODO(), def, SolveOpt{}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "security.unconfined is not allowed")
+
+	// the daemon does not allow insecure entitlements by default
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		AllowedEntitlements: []entitlements.Entitlement{entitlements.EntitlementSecurityUnconfined},
+	}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "security.unconfined is not allowed")
+}
+
 func testFrontendImageNaming(t *testing.T, sb integration.Sandbox) {
 	requiresLinux(t)
 	t.Parallel()
@@ -1433,6 +1471,138 @@ func testDuplicateWhiteouts(t *testing.T, sb integration.Sandbox) {
 }
 
 // #276
+func testProvenance(t *testing.T, sb integration.Sandbox) {
+	requiresLinux(t)
+	t.Parallel()
+	c, err := New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	st := llb.Image("busybox:latest").Run(llb.Shlex(`sh -c "echo -n foo > /foo"`)).Root()
+
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	destDir, err := ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(destDir)
+
+	out := filepath.Join(destDir, "out.tar")
+	outW, err := os.Create(out)
+	require.NoError(t, err)
+
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		Exporter: ExporterOCI,
+		ExporterAttrs: map[string]string{
+			"provenance": "true",
+		},
+		ExporterOutput: outW,
+	}, nil)
+	require.NoError(t, err)
+
+	dt, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+
+	m, err := testutil.ReadTarToMap(dt, false)
+	require.NoError(t, err)
+
+	var index ocispec.Index
+	err = json.Unmarshal(m["index.json"].Data, &index)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(index.Manifests))
+
+	err = json.Unmarshal(m["blobs/sha256/"+index.Manifests[0].Digest.Hex()].Data, &index)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(index.Manifests))
+
+	imgDesc, provDesc := index.Manifests[0], index.Manifests[1]
+	require.Equal(t, runtime.GOOS, imgDesc.Platform.OS)
+	require.Equal(t, "unknown", provDesc.Platform.OS)
+	require.Equal(t, "provenance", provDesc.Annotations["vnd.buildkit.reference.type"])
+	require.Equal(t, imgDesc.Digest.String(), provDesc.Annotations["vnd.buildkit.reference.digest"])
+
+	var mfst ocispec.Manifest
+	err = json.Unmarshal(m["blobs/sha256/"+provDesc.Digest.Hex()].Data, &mfst)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(mfst.Layers))
+	require.Equal(t, "application/vnd.buildkit.provenance.v0+json", mfst.Layers[0].MediaType)
+
+	var prov struct {
+		Definitions []*pb.Definition
+		Sources     []struct {
+			Identifier string
+			Pin        string
+		}
+		StartedOn  time.Time
+		FinishedOn time.Time
+	}
+	err = json.Unmarshal(m["blobs/sha256/"+mfst.Layers[0].Digest.Hex()].Data, &prov)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, len(prov.Definitions))
+	require.Equal(t, def.Def, prov.Definitions[0].Def)
+
+	require.Equal(t, 1, len(prov.Sources))
+	require.Equal(t, "docker-image://docker.io/library/busybox:latest", prov.Sources[0].Identifier)
+	require.True(t, strings.HasPrefix(prov.Sources[0].Pin, "sha256:"))
+
+	require.False(t, prov.StartedOn.IsZero())
+	require.False(t, prov.FinishedOn.Before(prov.StartedOn))
+}
+
+func testSBOM(t *testing.T, sb integration.Sandbox) {
+	requiresLinux(t)
+	t.Parallel()
+	c, err := New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	st := llb.Image("alpine:latest").Run(llb.Shlex(`sh -c "echo -n foo > /foo"`)).Root()
+
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	destDir, err := ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(destDir)
+
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		Exporter:          ExporterLocal,
+		ExporterOutputDir: destDir,
+		ExporterAttrs: map[string]string{
+			"sbom": "true",
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	dt, err := ioutil.ReadFile(filepath.Join(destDir, "foo"))
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(dt))
+
+	dt, err = ioutil.ReadFile(filepath.Join(destDir, "sbom.cdx.json"))
+	require.NoError(t, err)
+
+	var doc struct {
+		BOMFormat  string
+		Components []struct {
+			Name string
+			PURL string
+		}
+	}
+	err = json.Unmarshal(dt, &doc)
+	require.NoError(t, err)
+	require.Equal(t, "CycloneDX", doc.BOMFormat)
+
+	var found bool
+	for _, c := range doc.Components {
+		if c.Name == "musl" {
+			require.True(t, strings.HasPrefix(c.PURL, "pkg:apk/alpine/musl@"))
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
 func testWhiteoutParentDir(t *testing.T, sb integration.Sandbox) {
 	requiresLinux(t)
 	t.Parallel()
@@ -1495,6 +1665,78 @@ func testWhiteoutParentDir(t *testing.T, sb integration.Sandbox) {
 	require.True(t, ok)
 }
 
+func testDiffOpWhiteouts(t *testing.T, sb integration.Sandbox) {
+	requiresLinux(t)
+	t.Parallel()
+	c, err := New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	lower := llb.Scratch().File(llb.Mkfile("/del", 0644, []byte("del")).Mkfile("/keep", 0644, []byte("keep")))
+	upper := lower.File(llb.Rm("/del").Mkfile("/new", 0644, []byte("new")))
+	diff := llb.Diff(lower, upper)
+
+	def, err := diff.Marshal()
+	require.NoError(t, err)
+
+	// the deleted file is not visible in the result
+	destDir, err := ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(destDir)
+
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		Exporter:          ExporterLocal,
+		ExporterOutputDir: destDir,
+	}, nil)
+	require.NoError(t, err)
+
+	dt, err := ioutil.ReadFile(filepath.Join(destDir, "new"))
+	require.NoError(t, err)
+	require.Equal(t, "new", string(dt))
+	for _, name := range []string{"del", ".wh.del", "keep"} {
+		_, err = os.Stat(filepath.Join(destDir, name))
+		require.True(t, os.IsNotExist(err), name)
+	}
+
+	// but it is exported as a whiteout in the layer
+	out := filepath.Join(destDir, "out.tar")
+	outW, err := os.Create(out)
+	require.NoError(t, err)
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		Exporter:       ExporterOCI,
+		ExporterOutput: outW,
+	}, nil)
+	require.NoError(t, err)
+
+	dt, err = ioutil.ReadFile(out)
+	require.NoError(t, err)
+
+	m, err := testutil.ReadTarToMap(dt, false)
+	require.NoError(t, err)
+
+	var index ocispec.Index
+	err = json.Unmarshal(m["index.json"].Data, &index)
+	require.NoError(t, err)
+
+	var mfst ocispec.Manifest
+	err = json.Unmarshal(m["blobs/sha256/"+index.Manifests[0].Digest.Hex()].Data, &mfst)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(mfst.Layers))
+
+	layer, ok := m["blobs/sha256/"+mfst.Layers[0].Digest.Hex()]
+	require.True(t, ok)
+
+	m, err = testutil.ReadTarToMap(layer.Data, true)
+	require.NoError(t, err)
+
+	_, ok = m[".wh.del"]
+	require.True(t, ok)
+	_, ok = m["new"]
+	require.True(t, ok)
+	_, ok = m["keep"]
+	require.False(t, ok)
+}
+
 // #296
 func testSchema1Image(t *testing.T, sb integration.Sandbox) {
 	t.Parallel()
@@ -1890,3 +2132,156 @@ func (s *server) run(a agent.Agent) error {
 		go agent.ServeAgent(a, c)
 	}
 }
+
+func testOCILayoutSource(t *testing.T, sb integration.Sandbox) {
+	requiresLinux(t)
+	t.Parallel()
+	c, err := New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	st := llb.Scratch().File(llb.Mkfile("foo", 0600, []byte("layout")))
+
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	destDir, err := ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(destDir)
+
+	out := filepath.Join(destDir, "out.tar")
+	outW, err := os.Create(out)
+	require.NoError(t, err)
+
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		Exporter:       ExporterOCI,
+		ExporterOutput: outW,
+	}, nil)
+	require.NoError(t, err)
+
+	dt, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+
+	m, err := testutil.ReadTarToMap(dt, false)
+	require.NoError(t, err)
+
+	var index ocispec.Index
+	err = json.Unmarshal(m["index.json"].Data, &index)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(index.Manifests))
+
+	layoutDir := filepath.Join(destDir, "layout")
+	for name, f := range m {
+		if !strings.HasPrefix(name, "blobs/") || f.Header.Typeflag != tar.TypeReg {
+			continue
+		}
+		err = os.MkdirAll(filepath.Join(layoutDir, filepath.Dir(name)), 0700)
+		require.NoError(t, err)
+		err = ioutil.WriteFile(filepath.Join(layoutDir, name), f.Data, 0600)
+		require.NoError(t, err)
+	}
+
+	store, err := local.NewStore(layoutDir)
+	require.NoError(t, err)
+
+	st = llb.OCILayout("test", index.Manifests[0].Digest)
+
+	def, err = st.Marshal()
+	require.NoError(t, err)
+
+	outDir := filepath.Join(destDir, "out")
+
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		Exporter:          ExporterLocal,
+		ExporterOutputDir: outDir,
+		Session:           []session.Attachable{sessioncontent.NewAttachable(map[string]content.Store{"test": store})},
+	}, nil)
+	require.NoError(t, err)
+
+	dt, err = ioutil.ReadFile(filepath.Join(outDir, "foo"))
+	require.NoError(t, err)
+	require.Equal(t, "layout", string(dt))
+}
+
+func testSourcePolicy(t *testing.T, sb integration.Sandbox) {
+	requiresLinux(t)
+	t.Parallel()
+	c, err := New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	st := llb.Image("busybox:latest").Run(llb.Shlex(`sh -c "cat /etc/alpine-release > /out"`)).Root()
+
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	destDir, err := ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(destDir)
+
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		Exporter:          ExporterLocal,
+		ExporterOutputDir: destDir,
+		SourcePolicy: &spb.Policy{
+			Rules: []*spb.Rule{
+				{
+					Action:   spb.PolicyAction_CONVERT,
+					Selector: &spb.Selector{Identifier: "docker-image://docker.io/library/busybox:latest"},
+					Updates:  &spb.Update{Identifier: "docker-image://docker.io/library/alpine:latest"},
+				},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(destDir, "out"))
+	require.NoError(t, err)
+
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		SourcePolicy: &spb.Policy{
+			Rules: []*spb.Rule{
+				{
+					Action:   spb.PolicyAction_DENY,
+					Selector: &spb.Selector{Identifier: "docker-image://*"},
+				},
+			},
+		},
+	}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "denied by rule 1 of client source policy")
+}
+
+func testDebugShell(t *testing.T, sb integration.Sandbox) {
+	requiresLinux(t)
+	t.Parallel()
+	c, err := New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	st := llb.Image("busybox:latest").
+		Run(llb.Shlex(`sh -c "echo -n debug > /tmp/foo && exit 1"`), llb.AddEnv("FOO", "bar"), llb.Dir("/tmp")).Root()
+
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	ref := identity.NewID()
+	_, err = c.Solve(context.TODO(), def, SolveOpt{
+		Ref:            ref,
+		KeepFailedExec: true,
+	}, nil)
+	require.Error(t, err)
+
+	stdout := &bytes.Buffer{}
+	err = c.DebugShell(context.TODO(), ref, DebugShellOpt{
+		Args:   []string{"sh", "-c", "cat foo && echo -n \" $FOO $(pwd)\" && read line && echo -n \" $line\""},
+		Stdin:  strings.NewReader("stdin\n"),
+		Stdout: stdout,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "debug bar /tmp stdin", stdout.String())
+
+	// the state is released after the shell exits
+	err = c.DebugShell(context.TODO(), ref, DebugShellOpt{Args: []string{"true"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no failed exec")
+}
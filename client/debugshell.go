@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	controlapi "github.com/moby/buildkit/api/services/control"
+	"github.com/pkg/errors"
+)
+
+// DebugShellOpt describes the process started by DebugShell
+type DebugShellOpt struct {
+	Args   []string
+	Env    []string
+	Tty    bool
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	// Resize receives the new size of the terminal if Tty is set
+	Resize <-chan WinSize
+}
+
+// WinSize is the size of a terminal
+type WinSize struct {
+	Rows uint32
+	Cols uint32
+}
+
+// DebugShell runs a process in the state of the exec that failed the build
+// ref. The build needs to be solved with SolveOpt.KeepFailedExec. The state
+// is released when the process exits.
+func (c *Client) DebugShell(ctx context.Context, ref string, opt DebugShellOpt) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.controlClient().DebugShell(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to start debug shell")
+	}
+
+	var mu sync.Mutex
+	send := func(req *controlapi.DebugShellRequest) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return stream.Send(req)
+	}
+
+	if err := send(&controlapi.DebugShellRequest{Request: &controlapi.DebugShellRequest_Init{Init: &controlapi.DebugShellInit{
+		Ref:  ref,
+		Args: opt.Args,
+		Env:  opt.Env,
+		Tty:  opt.Tty,
+	}}}); err != nil {
+		return errors.Wrap(err, "failed to start debug shell")
+	}
+
+	if opt.Stdin != nil {
+		go func() {
+			buf := make([]byte, 32*1024)
+			for {
+				n, err := opt.Stdin.Read(buf)
+				if n > 0 {
+					dt := append([]byte{}, buf[:n]...)
+					if err := send(&controlapi.DebugShellRequest{Request: &controlapi.DebugShellRequest_Stdin{Stdin: dt}}); err != nil {
+						return
+					}
+				}
+				if err != nil {
+					send(&controlapi.DebugShellRequest{Request: &controlapi.DebugShellRequest_CloseStdin{CloseStdin: true}})
+					return
+				}
+			}
+		}()
+	}
+
+	if opt.Resize != nil {
+		go func() {
+			for {
+				select {
+				case ws := <-opt.Resize:
+					if err := send(&controlapi.DebugShellRequest{Request: &controlapi.DebugShellRequest_Resize{Resize: &controlapi.WinSize{Rows: ws.Rows, Cols: ws.Cols}}}); err != nil {
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return errors.Errorf("debug shell ended without exit status")
+			}
+			return errors.Wrap(err, "failed to receive debug shell output")
+		}
+		switch r := resp.Response.(type) {
+		case *controlapi.DebugShellResponse_Stdout:
+			if opt.Stdout != nil {
+				opt.Stdout.Write(r.Stdout)
+			}
+		case *controlapi.DebugShellResponse_Stderr:
+			if opt.Stderr != nil {
+				opt.Stderr.Write(r.Stderr)
+			}
+		case *controlapi.DebugShellResponse_Exit:
+			if r.Exit != nil && r.Exit.Error != "" {
+				return errors.New(r.Exit.Error)
+			}
+			return nil
+		}
+	}
+}
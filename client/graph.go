@@ -3,6 +3,7 @@ package client
 import (
 	"time"
 
+	"github.com/moby/buildkit/solver/pb"
 	digest "github.com/opencontainers/go-digest"
 )
 
@@ -34,12 +35,38 @@ type VertexLog struct {
 	Timestamp time.Time
 }
 
+// VertexWarning is a warning emitted by a frontend. Vertex is empty for
+// warnings that are not about a vertex. Level is one of the WarnLevel
+// constants of the gateway client.
+type VertexWarning struct {
+	Vertex     digest.Digest
+	Level      int
+	Short      []byte
+	Detail     [][]byte
+	SourceInfo *pb.SourceInfo
+	Range      []*pb.Range
+	Timestamp  time.Time
+}
+
 type SolveStatus struct {
 	Vertexes []*Vertex
 	Statuses []*VertexStatus
 	Logs     []*VertexLog
+	Warnings []*VertexWarning
 }
 
 type SolveResponse struct {
 	ExporterResponse map[string]string
+	// DryRun is set for dry run solves
+	DryRun []*DryRunVertex
+	// Warnings are all warnings emitted during the build
+	Warnings []*VertexWarning
+}
+
+// DryRunVertex is the expected state of a vertex in a dry run
+type DryRunVertex struct {
+	Digest    digest.Digest
+	Name      string
+	Cached    bool
+	InputSize int64
 }
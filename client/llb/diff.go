@@ -0,0 +1,111 @@
+package llb
+
+import (
+	_ "crypto/sha256"
+
+	"github.com/moby/buildkit/solver/pb"
+	digest "github.com/opencontainers/go-digest"
+)
+
+// DiffOp is a vertex that returns the changes between its lower and upper
+// inputs
+type DiffOp struct {
+	MarshalCache
+	lower       Output
+	upper       Output
+	output      Output
+	constraints Constraints
+}
+
+func NewDiff(lower, upper State, c Constraints) *DiffOp {
+	op := &DiffOp{
+		lower:       lower.Output(),
+		upper:       upper.Output(),
+		constraints: c,
+	}
+	op.output = &output{vertex: op, getIndex: func() (pb.OutputIndex, error) {
+		return pb.OutputIndex(0), nil
+	}, platform: c.Platform}
+	return op
+}
+
+func (d *DiffOp) Validate() error {
+	return nil
+}
+
+func (d *DiffOp) Marshal(c *Constraints) (digest.Digest, []byte, *pb.OpMetadata, []*SourceLocation, error) {
+	if d.Cached(c) {
+		return d.Load()
+	}
+	if err := d.Validate(); err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	addCap(&d.constraints, pb.CapDiffOp)
+
+	pop, md := MarshalConstraints(c, &d.constraints)
+	pd := &pb.DiffOp{
+		Lower: &pb.LowerDiffInput{Input: pb.InputIndex(-1)},
+		Upper: &pb.UpperDiffInput{Input: pb.InputIndex(-1)},
+	}
+
+	if d.lower != nil {
+		inp, err := d.lower.ToInput(c)
+		if err != nil {
+			return "", nil, nil, nil, err
+		}
+		pd.Lower.Input = pb.InputIndex(len(pop.Inputs))
+		pop.Inputs = append(pop.Inputs, inp)
+	}
+	if d.upper != nil {
+		inp, err := d.upper.ToInput(c)
+		if err != nil {
+			return "", nil, nil, nil, err
+		}
+		pd.Upper.Input = pb.InputIndex(len(pop.Inputs))
+		pop.Inputs = append(pop.Inputs, inp)
+	}
+
+	pop.Op = &pb.Op_Diff{
+		Diff: pd,
+	}
+
+	dt, err := pop.Marshal()
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+	d.Store(dt, md, d.constraints.SourceLocations, c)
+	return d.Load()
+}
+
+func (d *DiffOp) Output() Output {
+	return d.output
+}
+
+func (d *DiffOp) Inputs() []Output {
+	var out []Output
+	if d.lower != nil {
+		out = append(out, d.lower)
+	}
+	if d.upper != nil {
+		out = append(out, d.upper)
+	}
+	return out
+}
+
+// Diff returns a state that only contains the changes from lower to upper.
+// Deleted files are not part of the result but are exported as whiteouts so
+// the result can be exported as a single layer, and are removed when the
+// result is merged on top of another state with Merge.
+func Diff(lower, upper State, opts ...ConstraintsOpt) State {
+	if lower.Output() == nil && upper.Output() == nil {
+		return Scratch()
+	}
+
+	var c Constraints
+	for _, o := range opts {
+		o.SetConstraintsOption(&c)
+	}
+
+	return upper.WithOutput(NewDiff(lower, upper, c).Output())
+}
@@ -0,0 +1,54 @@
+package llb
+
+import (
+	"testing"
+
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	base := Image("foo")
+	st := Diff(base, base.Run(Shlex("apt-get install bar")).Root())
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	require.Equal(t, 4, len(arr))
+
+	last := arr[len(arr)-1]
+	require.Equal(t, 1, len(last.Inputs))
+
+	op := m[last.Inputs[0].Digest]
+	diff := op.Op.(*pb.Op_Diff).Diff
+	require.Equal(t, 2, len(op.Inputs))
+	require.Equal(t, pb.InputIndex(0), diff.Lower.Input)
+	require.Equal(t, pb.InputIndex(1), diff.Upper.Input)
+
+	_, ok := m[op.Inputs[0].Digest].Op.(*pb.Op_Source)
+	require.True(t, ok)
+	_, ok = m[op.Inputs[1].Digest].Op.(*pb.Op_Exec)
+	require.True(t, ok)
+}
+
+func TestDiffScratch(t *testing.T) {
+	t.Parallel()
+
+	st := Diff(Scratch(), Image("foo"))
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	require.Equal(t, 3, len(arr))
+
+	op := m[arr[len(arr)-1].Inputs[0].Digest]
+	diff := op.Op.(*pb.Op_Diff).Diff
+	require.Equal(t, 1, len(op.Inputs))
+	require.Equal(t, pb.InputIndex(-1), diff.Lower.Input)
+	require.Equal(t, pb.InputIndex(0), diff.Upper.Input)
+
+	st = Diff(Scratch(), Scratch())
+	require.Nil(t, st.Output())
+}
@@ -19,6 +19,8 @@ type Meta struct {
 	ProxyEnv   *ProxyEnv
 	ExtraHosts []HostIP
 	Network    pb.NetMode
+	Security   pb.SecurityMode
+	Resources  *ResourceLimits
 }
 
 func NewExecOp(root Output, meta Meta, readOnly bool, c Constraints) *ExecOp {
@@ -85,7 +87,7 @@ func (e *ExecOp) AddMount(target string, source Output, opt ...MountOption) Outp
 		}
 		m.output = o
 	}
-	e.Store(nil, nil, nil)
+	e.Store(nil, nil, nil, nil)
 	e.isValidated = false
 	return m.output
 }
@@ -120,12 +122,12 @@ func (e *ExecOp) Validate() error {
 	return nil
 }
 
-func (e *ExecOp) Marshal(c *Constraints) (digest.Digest, []byte, *pb.OpMetadata, error) {
+func (e *ExecOp) Marshal(c *Constraints) (digest.Digest, []byte, *pb.OpMetadata, []*SourceLocation, error) {
 	if e.Cached(c) {
 		return e.Load()
 	}
 	if err := e.Validate(); err != nil {
-		return "", nil, nil, err
+		return "", nil, nil, nil, err
 	}
 	// make sure mounts are sorted
 	sort.Slice(e.mounts, func(i, j int) bool {
@@ -158,13 +160,29 @@ func (e *ExecOp) Marshal(c *Constraints) (digest.Digest, []byte, *pb.OpMetadata,
 	}
 
 	peo := &pb.ExecOp{
-		Meta:    meta,
-		Network: e.meta.Network,
+		Meta:     meta,
+		Network:  e.meta.Network,
+		Security: e.meta.Security,
 	}
 	if e.meta.Network != NetModeSandbox {
 		addCap(&e.constraints, pb.CapExecMetaNetwork)
 	}
 
+	if e.meta.Security != SecurityModeSandbox {
+		addCap(&e.constraints, pb.CapExecMetaSecurity)
+	}
+
+	if r := e.meta.Resources; r != nil {
+		peo.ResourceLimits = &pb.ResourceLimits{
+			CpuShares: r.CPUShares,
+			CpuQuota:  r.CPUQuota,
+			CpuPeriod: r.CPUPeriod,
+			Memory:    r.Memory,
+			Pids:      r.Pids,
+		}
+		addCap(&e.constraints, pb.CapExecMetaResources)
+	}
+
 	if p := e.meta.ProxyEnv; p != nil {
 		peo.Meta.ProxyEnv = &pb.ProxyEnv{
 			HttpProxy:  p.HttpProxy,
@@ -201,11 +219,11 @@ func (e *ExecOp) Marshal(c *Constraints) (digest.Digest, []byte, *pb.OpMetadata,
 		inputIndex := pb.InputIndex(len(pop.Inputs))
 		if m.source != nil {
 			if m.tmpfs {
-				return "", nil, nil, errors.Errorf("tmpfs mounts must use scratch")
+				return "", nil, nil, nil, errors.Errorf("tmpfs mounts must use scratch")
 			}
 			inp, err := m.source.ToInput(c)
 			if err != nil {
-				return "", nil, nil, err
+				return "", nil, nil, nil, err
 			}
 
 			newInput := true
@@ -294,9 +312,9 @@ func (e *ExecOp) Marshal(c *Constraints) (digest.Digest, []byte, *pb.OpMetadata,
 
 	dt, err := pop.Marshal()
 	if err != nil {
-		return "", nil, nil, err
+		return "", nil, nil, nil, err
 	}
-	e.Store(dt, md, c)
+	e.Store(dt, md, e.constraints.SourceLocations, c)
 	return e.Load()
 }
 
@@ -396,6 +414,12 @@ func Network(n pb.NetMode) RunOption {
 	})
 }
 
+func Security(s pb.SecurityMode) RunOption {
+	return runOptionFunc(func(ei *ExecInfo) {
+		ei.State = security(s)(ei.State)
+	})
+}
+
 func Shlex(str string) RunOption {
 	return Shlexf(str)
 }
@@ -569,6 +593,37 @@ func WithProxy(ps ProxyEnv) RunOption {
 	})
 }
 
+// CPUShares sets the relative CPU weight of the process
+func CPUShares(shares uint64) RunOption {
+	return runOptionFunc(func(ei *ExecInfo) {
+		ei.resources().CPUShares = shares
+	})
+}
+
+// CPUQuota limits the CPU time of the process to quota microseconds in every
+// period. A zero period uses the default of the runtime.
+func CPUQuota(quota int64, period uint64) RunOption {
+	return runOptionFunc(func(ei *ExecInfo) {
+		r := ei.resources()
+		r.CPUQuota = quota
+		r.CPUPeriod = period
+	})
+}
+
+// MemoryLimit limits the memory of the process in bytes
+func MemoryLimit(bytes int64) RunOption {
+	return runOptionFunc(func(ei *ExecInfo) {
+		ei.resources().Memory = bytes
+	})
+}
+
+// PidsLimit limits the number of processes and threads
+func PidsLimit(pids int64) RunOption {
+	return runOptionFunc(func(ei *ExecInfo) {
+		ei.resources().Pids = pids
+	})
+}
+
 type ExecInfo struct {
 	constraintsWrapper
 	State          State
@@ -577,6 +632,14 @@ type ExecInfo struct {
 	ProxyEnv       *ProxyEnv
 	Secrets        []SecretInfo
 	SSH            []SSHInfo
+	Resources      *ResourceLimits
+}
+
+func (ei *ExecInfo) resources() *ResourceLimits {
+	if ei.Resources == nil {
+		ei.Resources = &ResourceLimits{}
+	}
+	return ei.Resources
 }
 
 type MountInfo struct {
@@ -592,6 +655,15 @@ type ProxyEnv struct {
 	NoProxy    string
 }
 
+// ResourceLimits are the cgroup limits of a process. Zero values are unset.
+type ResourceLimits struct {
+	CPUShares uint64
+	CPUQuota  int64
+	CPUPeriod uint64
+	Memory    int64
+	Pids      int64
+}
+
 type CacheMountSharingMode int
 
 const (
@@ -605,3 +677,8 @@ const (
 	NetModeHost    = pb.NetMode_HOST
 	NetModeNone    = pb.NetMode_NONE
 )
+
+const (
+	SecurityModeInsecure = pb.SecurityMode_INSECURE
+	SecurityModeSandbox  = pb.SecurityMode_SANDBOX
+)
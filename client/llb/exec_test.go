@@ -2,7 +2,10 @@ package llb
 
 import (
 	"testing"
+	"time"
 
+	"github.com/moby/buildkit/solver/pb"
+	digest "github.com/opencontainers/go-digest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,3 +27,44 @@ func TestTmpfsMountError(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "must use scratch")
 }
+
+func TestExecResourceLimits(t *testing.T) {
+	t.Parallel()
+
+	st := Image("foo").Run(Shlex("args"), MemoryLimit(1<<20), CPUQuota(50000, 100000), PidsLimit(10)).Root()
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	exec := m[arr[len(arr)-1].Inputs[0].Digest].Op.(*pb.Op_Exec).Exec
+	require.NotNil(t, exec.ResourceLimits)
+	require.Equal(t, int64(1<<20), exec.ResourceLimits.Memory)
+	require.Equal(t, int64(50000), exec.ResourceLimits.CpuQuota)
+	require.Equal(t, uint64(100000), exec.ResourceLimits.CpuPeriod)
+	require.Equal(t, int64(10), exec.ResourceLimits.Pids)
+	require.Equal(t, uint64(0), exec.ResourceLimits.CpuShares)
+
+	st = Image("foo").Run(Shlex("args")).Root()
+	def, err = st.Marshal()
+	require.NoError(t, err)
+
+	m, arr = parseDef(t, def.Def)
+	exec = m[arr[len(arr)-1].Inputs[0].Digest].Op.(*pb.Op_Exec).Exec
+	require.Nil(t, exec.ResourceLimits)
+}
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	st := Image("foo").Run(Shlex("args"), Timeout(1500*time.Millisecond)).Root()
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	dgst := arr[len(arr)-1].Inputs[0].Digest
+	require.Equal(t, int64(2), def.Metadata[dgst].Timeout)
+	require.True(t, def.Metadata[digest.FromBytes(def.Def[len(def.Def)-1])].Caps[pb.CapMetaTimeout])
+
+	_, ok := m[dgst].Op.(*pb.Op_Exec)
+	require.True(t, ok)
+}
@@ -0,0 +1,205 @@
+package llb
+
+import (
+	"testing"
+
+	"github.com/moby/buildkit/solver/pb"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileMkdir(t *testing.T) {
+	t.Parallel()
+
+	st := Image("foo").File(Mkdir("/foo", 0700))
+	def, err := st.Marshal()
+
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	require.Equal(t, 3, len(arr))
+
+	last := arr[len(arr)-1]
+	require.Equal(t, 1, len(last.Inputs))
+
+	f := arr[1].Op.(*pb.Op_File).File
+	require.Equal(t, m[last.Inputs[0].Digest], arr[1])
+	require.Equal(t, 1, len(arr[1].Inputs))
+	require.Equal(t, m[arr[1].Inputs[0].Digest], arr[0])
+
+	require.Equal(t, 1, len(f.Actions))
+
+	action := f.Actions[0]
+	require.Equal(t, 0, int(action.Input))
+	require.Equal(t, -1, int(action.SecondaryInput))
+	require.Equal(t, 0, int(action.Output))
+
+	mkdir := action.Action.(*pb.FileAction_Mkdir).Mkdir
+
+	require.Equal(t, "/foo", mkdir.Path)
+	require.Equal(t, 0700, int(mkdir.Mode))
+	require.Equal(t, int64(-1), mkdir.Timestamp)
+}
+
+func TestFileMkdirChain(t *testing.T) {
+	t.Parallel()
+
+	st := Image("foo").Dir("/etc").File(Mkdir("/foo", 0700).Mkdir("bar", 0600, WithParents(true)).Mkdir("bar/baz", 0701, WithParents(false)))
+	def, err := st.Marshal()
+
+	require.NoError(t, err)
+
+	_, arr := parseDef(t, def.Def)
+	require.Equal(t, 3, len(arr))
+
+	f := arr[1].Op.(*pb.Op_File).File
+	require.Equal(t, 1, len(arr[1].Inputs))
+	require.Equal(t, 3, len(f.Actions))
+
+	action := f.Actions[0]
+	require.Equal(t, 0, int(action.Input))
+	require.Equal(t, -1, int(action.SecondaryInput))
+	require.Equal(t, -1, int(action.Output))
+	mkdir := action.Action.(*pb.FileAction_Mkdir).Mkdir
+	require.Equal(t, "/foo", mkdir.Path)
+	require.Equal(t, 0700, int(mkdir.Mode))
+	require.Equal(t, false, mkdir.MakeParents)
+
+	action = f.Actions[1]
+	require.Equal(t, 1, int(action.Input))
+	require.Equal(t, -1, int(action.SecondaryInput))
+	require.Equal(t, -1, int(action.Output))
+	mkdir = action.Action.(*pb.FileAction_Mkdir).Mkdir
+	require.Equal(t, "/etc/bar", mkdir.Path)
+	require.Equal(t, 0600, int(mkdir.Mode))
+	require.Equal(t, true, mkdir.MakeParents)
+
+	action = f.Actions[2]
+	require.Equal(t, 2, int(action.Input))
+	require.Equal(t, -1, int(action.SecondaryInput))
+	require.Equal(t, 0, int(action.Output))
+	mkdir = action.Action.(*pb.FileAction_Mkdir).Mkdir
+	require.Equal(t, "/etc/bar/baz", mkdir.Path)
+	require.Equal(t, 0701, int(mkdir.Mode))
+	require.Equal(t, false, mkdir.MakeParents)
+}
+
+func TestFileMkfile(t *testing.T) {
+	t.Parallel()
+
+	st := Image("foo").File(Mkfile("/foo", 0700, []byte("data"), WithUser("foo:1002")))
+	def, err := st.Marshal()
+
+	require.NoError(t, err)
+
+	_, arr := parseDef(t, def.Def)
+	require.Equal(t, 3, len(arr))
+
+	f := arr[1].Op.(*pb.Op_File).File
+	require.Equal(t, 1, len(f.Actions))
+
+	mkfile := f.Actions[0].Action.(*pb.FileAction_Mkfile).Mkfile
+	require.Equal(t, "/foo", mkfile.Path)
+	require.Equal(t, 0700, int(mkfile.Mode))
+	require.Equal(t, "data", string(mkfile.Data))
+
+	require.Equal(t, "foo", mkfile.Owner.User.User.(*pb.UserOpt_ByName).ByName.Name)
+	require.Equal(t, 0, int(mkfile.Owner.User.User.(*pb.UserOpt_ByName).ByName.Input))
+	require.Equal(t, 1002, int(mkfile.Owner.Group.User.(*pb.UserOpt_ByID).ByID))
+}
+
+func TestFileRm(t *testing.T) {
+	t.Parallel()
+
+	st := Image("foo").Dir("/tmp").File(Rm("foo*", WithAllowNotFound(true), WithAllowWildcard(true)))
+	def, err := st.Marshal()
+
+	require.NoError(t, err)
+
+	_, arr := parseDef(t, def.Def)
+	require.Equal(t, 3, len(arr))
+
+	f := arr[1].Op.(*pb.Op_File).File
+	require.Equal(t, 1, len(f.Actions))
+
+	rm := f.Actions[0].Action.(*pb.FileAction_Rm).Rm
+	require.Equal(t, "/tmp/foo*", rm.Path)
+	require.Equal(t, true, rm.AllowNotFound)
+	require.Equal(t, true, rm.AllowWildcard)
+}
+
+func TestFileSimpleCopy(t *testing.T) {
+	t.Parallel()
+
+	st := Image("foo").Dir("/tmp").File(Copy(Image("bar").Dir("/etc"), "foo", "bar/", &CopyInfo{FollowSymlinks: true}))
+	def, err := st.Marshal()
+
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	require.Equal(t, 4, len(arr))
+
+	last := arr[len(arr)-1]
+	fop := m[last.Inputs[0].Digest]
+	require.Equal(t, 2, len(fop.Inputs))
+
+	f := fop.Op.(*pb.Op_File).File
+	require.Equal(t, 1, len(f.Actions))
+
+	action := f.Actions[0]
+	require.Equal(t, 0, int(action.Input))
+	require.Equal(t, 1, int(action.SecondaryInput))
+	require.Equal(t, 0, int(action.Output))
+
+	copy := action.Action.(*pb.FileAction_Copy).Copy
+	require.Equal(t, "/etc/foo", copy.Src)
+	require.Equal(t, "/tmp/bar/", copy.Dest)
+	require.Equal(t, true, copy.FollowSymlink)
+	require.Equal(t, -1, int(copy.Mode))
+}
+
+func TestFileCopyFromAction(t *testing.T) {
+	t.Parallel()
+
+	st := Scratch().File(Copy(Mkdir("/foo", 0755).Mkfile("/foo/bar", 0600, []byte("dt")).WithState(Scratch()), "/foo", "/out"))
+	def, err := st.Marshal()
+
+	require.NoError(t, err)
+
+	_, arr := parseDef(t, def.Def)
+	require.Equal(t, 2, len(arr))
+
+	f := arr[0].Op.(*pb.Op_File).File
+	require.Equal(t, 0, len(arr[0].Inputs))
+	require.Equal(t, 3, len(f.Actions))
+
+	action := f.Actions[0]
+	require.Equal(t, -1, int(action.Input))
+	require.Equal(t, -1, int(action.Output))
+
+	action = f.Actions[1]
+	require.Equal(t, 0, int(action.Input))
+	require.Equal(t, -1, int(action.Output))
+
+	action = f.Actions[2]
+	require.Equal(t, -1, int(action.Input))
+	require.Equal(t, 1, int(action.SecondaryInput))
+	require.Equal(t, 0, int(action.Output))
+	require.Equal(t, "/foo", action.Action.(*pb.FileAction_Copy).Copy.Src)
+}
+
+func parseDef(t *testing.T, def [][]byte) (map[digest.Digest]pb.Op, []pb.Op) {
+	m := map[digest.Digest]pb.Op{}
+	arr := make([]pb.Op, 0, len(def))
+
+	for _, dt := range def {
+		var op pb.Op
+		err := (&op).Unmarshal(dt)
+		require.NoError(t, err)
+		dgst := digest.FromBytes(dt)
+		m[dgst] = op
+		arr = append(arr, op)
+	}
+
+	return m, arr
+}
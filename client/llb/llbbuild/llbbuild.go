@@ -31,7 +31,7 @@ type build struct {
 }
 
 func (b *build) ToInput(c *llb.Constraints) (*pb.Input, error) {
-	dgst, _, _, err := b.Marshal(c)
+	dgst, _, _, _, err := b.Marshal(c)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +46,7 @@ func (b *build) Validate() error {
 	return nil
 }
 
-func (b *build) Marshal(c *llb.Constraints) (digest.Digest, []byte, *pb.OpMetadata, error) {
+func (b *build) Marshal(c *llb.Constraints) (digest.Digest, []byte, *pb.OpMetadata, []*llb.SourceLocation, error) {
 	if b.Cached(c) {
 		return b.Load()
 	}
@@ -74,16 +74,16 @@ func (b *build) Marshal(c *llb.Constraints) (digest.Digest, []byte, *pb.OpMetada
 
 	inp, err := b.source.ToInput(c)
 	if err != nil {
-		return "", nil, nil, err
+		return "", nil, nil, nil, err
 	}
 
 	pop.Inputs = append(pop.Inputs, inp)
 
 	dt, err := pop.Marshal()
 	if err != nil {
-		return "", nil, nil, err
+		return "", nil, nil, nil, err
 	}
-	b.Store(dt, md, c)
+	b.Store(dt, md, b.constraints.SourceLocations, c)
 	return b.Load()
 }
 
@@ -12,7 +12,7 @@ import (
 func TestMarshal(t *testing.T) {
 	t.Parallel()
 	b := NewBuildOp(newDummyOutput("foobar"), WithFilename("myfilename"))
-	dgst, dt, opMeta, err := b.Marshal(&llb.Constraints{})
+	dgst, dt, opMeta, _, err := b.Marshal(&llb.Constraints{})
 	_ = opMeta
 	require.NoError(t, err)
 
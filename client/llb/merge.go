@@ -0,0 +1,116 @@
+package llb
+
+import (
+	_ "crypto/sha256"
+
+	"github.com/moby/buildkit/solver/pb"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+// MergeOp is a vertex that stacks the layers of its inputs on top of each
+// other without copying the files
+type MergeOp struct {
+	MarshalCache
+	inputs      []Output
+	output      Output
+	constraints Constraints
+}
+
+func NewMerge(inputs []State, c Constraints) *MergeOp {
+	op := &MergeOp{constraints: c}
+	for _, st := range inputs {
+		if out := st.Output(); out != nil {
+			op.inputs = append(op.inputs, out)
+		}
+	}
+	op.output = &output{vertex: op, getIndex: func() (pb.OutputIndex, error) {
+		return pb.OutputIndex(0), nil
+	}, platform: c.Platform}
+	return op
+}
+
+func (m *MergeOp) Validate() error {
+	if len(m.inputs) < 2 {
+		return errors.Errorf("merge requires at least 2 inputs")
+	}
+	return nil
+}
+
+func (m *MergeOp) Marshal(c *Constraints) (digest.Digest, []byte, *pb.OpMetadata, []*SourceLocation, error) {
+	if m.Cached(c) {
+		return m.Load()
+	}
+	if err := m.Validate(); err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	addCap(&m.constraints, pb.CapMergeOp)
+
+	pop, md := MarshalConstraints(c, &m.constraints)
+	pm := &pb.MergeOp{}
+
+	for _, out := range m.inputs {
+		inp, err := out.ToInput(c)
+		if err != nil {
+			return "", nil, nil, nil, err
+		}
+		idx := pb.InputIndex(-1)
+		for i, inp2 := range pop.Inputs {
+			if *inp == *inp2 {
+				idx = pb.InputIndex(i)
+				break
+			}
+		}
+		if idx == -1 {
+			idx = pb.InputIndex(len(pop.Inputs))
+			pop.Inputs = append(pop.Inputs, inp)
+		}
+		pm.Inputs = append(pm.Inputs, &pb.MergeInput{Input: idx})
+	}
+
+	pop.Op = &pb.Op_Merge{
+		Merge: pm,
+	}
+
+	dt, err := pop.Marshal()
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+	m.Store(dt, md, m.constraints.SourceLocations, c)
+	return m.Load()
+}
+
+func (m *MergeOp) Output() Output {
+	return m.output
+}
+
+func (m *MergeOp) Inputs() []Output {
+	return m.inputs
+}
+
+// Merge returns a state that contains the files of all inputs. Files from
+// inputs later in the list override the ones from earlier inputs. Scratch
+// inputs are ignored.
+func Merge(inputs []State, opts ...ConstraintsOpt) State {
+	var c Constraints
+	for _, o := range opts {
+		o.SetConstraintsOption(&c)
+	}
+
+	var states []State
+	for _, st := range inputs {
+		if st.Output() != nil {
+			states = append(states, st)
+		}
+	}
+
+	switch len(states) {
+	case 0:
+		return Scratch()
+	case 1:
+		return states[0]
+	}
+
+	return states[0].WithOutput(NewMerge(states, c).Output())
+}
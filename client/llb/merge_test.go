@@ -0,0 +1,51 @@
+package llb
+
+import (
+	"testing"
+
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	a := Image("foo")
+	b := Image("bar")
+	st := Merge([]State{a, Scratch(), b, a})
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	require.Equal(t, 4, len(arr))
+
+	last := arr[len(arr)-1]
+	require.Equal(t, 1, len(last.Inputs))
+
+	op := m[last.Inputs[0].Digest]
+	merge := op.Op.(*pb.Op_Merge).Merge
+	require.Equal(t, 2, len(op.Inputs))
+	require.Equal(t, 3, len(merge.Inputs))
+	require.Equal(t, pb.InputIndex(0), merge.Inputs[0].Input)
+	require.Equal(t, pb.InputIndex(1), merge.Inputs[1].Input)
+	require.Equal(t, pb.InputIndex(0), merge.Inputs[2].Input)
+
+	require.Equal(t, "docker-image://docker.io/library/foo:latest", m[op.Inputs[0].Digest].Op.(*pb.Op_Source).Source.Identifier)
+	require.Equal(t, "docker-image://docker.io/library/bar:latest", m[op.Inputs[1].Digest].Op.(*pb.Op_Source).Source.Identifier)
+}
+
+func TestMergeSingleInput(t *testing.T) {
+	t.Parallel()
+
+	st := Merge([]State{Scratch(), Image("foo")})
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	_, arr := parseDef(t, def.Def)
+	require.Equal(t, 2, len(arr))
+	_, ok := arr[0].Op.(*pb.Op_Source)
+	require.True(t, ok)
+
+	st = Merge(nil)
+	require.Nil(t, st.Output())
+}
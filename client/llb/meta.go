@@ -21,6 +21,7 @@ var (
 	keyExtraHost = contextKeyT("llb.exec.extrahost")
 	keyPlatform  = contextKeyT("llb.platform")
 	keyNetwork   = contextKeyT("llb.network")
+	keySecurity  = contextKeyT("llb.security")
 )
 
 func addEnv(key, value string) StateOption {
@@ -162,6 +163,21 @@ func getNetwork(s State) pb.NetMode {
 	return NetModeSandbox
 }
 
+func security(s pb.SecurityMode) StateOption {
+	return func(st State) State {
+		return st.WithValue(keySecurity, s)
+	}
+}
+
+func getSecurity(s State) pb.SecurityMode {
+	v := s.Value(keySecurity)
+	if v != nil {
+		n := v.(pb.SecurityMode)
+		return n
+	}
+	return SecurityModeSandbox
+}
+
 type EnvList []KeyValue
 
 type KeyValue struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 	_ "crypto/sha256"
 	"encoding/json"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -45,12 +46,12 @@ func (s *SourceOp) Validate() error {
 	return nil
 }
 
-func (s *SourceOp) Marshal(constraints *Constraints) (digest.Digest, []byte, *pb.OpMetadata, error) {
+func (s *SourceOp) Marshal(constraints *Constraints) (digest.Digest, []byte, *pb.OpMetadata, []*SourceLocation, error) {
 	if s.Cached(constraints) {
 		return s.Load()
 	}
 	if err := s.Validate(); err != nil {
-		return "", nil, nil, err
+		return "", nil, nil, nil, err
 	}
 
 	if strings.HasPrefix(s.id, "local://") {
@@ -75,10 +76,10 @@ func (s *SourceOp) Marshal(constraints *Constraints) (digest.Digest, []byte, *pb
 
 	dt, err := proto.Marshal()
 	if err != nil {
-		return "", nil, nil, err
+		return "", nil, nil, nil, err
 	}
 
-	s.Store(dt, md, constraints)
+	s.Store(dt, md, s.constraints.SourceLocations, constraints)
 	return s.Load()
 }
 
@@ -201,14 +202,25 @@ type ImageInfo struct {
 	RecordType   string
 }
 
+// Git returns the checkout of ref from the repository remote. ref can be
+// followed by ":subdir" to return only a subdirectory of the repository,
+// the .git directory is not kept in that case. The ref and subdir can also
+// be passed as a fragment of remote, e.g. "https://github.com/moby/buildkit.git#master:docs".
 func Git(remote, ref string, opts ...GitOption) State {
+	if parts := strings.SplitN(remote, "#", 2); len(parts) == 2 {
+		remote = parts[0]
+		if ref == "" {
+			ref = parts[1]
+		}
+	}
+
 	url := ""
 
 	for _, prefix := range []string{
 		"http://", "https://", "git://", "git@",
 	} {
 		if strings.HasPrefix(remote, prefix) {
-			url = strings.Split(remote, "#")[0]
+			url = remote
 			remote = strings.TrimPrefix(remote, prefix)
 		}
 	}
@@ -232,6 +244,25 @@ func Git(remote, ref string, opts ...GitOption) State {
 		attrs[pb.AttrFullRemoteURL] = url
 		addCap(&gi.Constraints, pb.CapSourceGitFullURL)
 	}
+	if strings.Contains(ref, ":") {
+		addCap(&gi.Constraints, pb.CapSourceGitSubdir)
+	}
+	if gi.AuthTokenSecret != "" {
+		attrs[pb.AttrAuthTokenSecret] = gi.AuthTokenSecret
+		addCap(&gi.Constraints, pb.CapSourceGitHTTPAuth)
+	}
+	if gi.AuthHeaderSecret != "" {
+		attrs[pb.AttrAuthHeaderSecret] = gi.AuthHeaderSecret
+		addCap(&gi.Constraints, pb.CapSourceGitHTTPAuth)
+	}
+	if gi.KnownSSHHosts != "" {
+		attrs[pb.AttrKnownSSHHosts] = gi.KnownSSHHosts
+		addCap(&gi.Constraints, pb.CapSourceGitKnownSSHHosts)
+	}
+	if gi.MountSSHSock != "" {
+		attrs[pb.AttrMountSSHSock] = gi.MountSSHSock
+		addCap(&gi.Constraints, pb.CapSourceGitMountSSHSock)
+	}
 
 	addCap(&gi.Constraints, pb.CapSourceGit)
 
@@ -250,7 +281,11 @@ func (fn gitOptionFunc) SetGitOption(gi *GitInfo) {
 
 type GitInfo struct {
 	constraintsWrapper
-	KeepGitDir bool
+	KeepGitDir       bool
+	AuthTokenSecret  string
+	AuthHeaderSecret string
+	KnownSSHHosts    string
+	MountSSHSock     string
 }
 
 func KeepGitDir() GitOption {
@@ -259,6 +294,54 @@ func KeepGitDir() GitOption {
 	})
 }
 
+// AuthTokenSecret sets the ID of the session secret with a token that is
+// used to authenticate to HTTP remotes
+func AuthTokenSecret(v string) GitOption {
+	return gitOptionFunc(func(gi *GitInfo) {
+		gi.AuthTokenSecret = v
+	})
+}
+
+// AuthOption is an option that applies to both Git and HTTP sources
+type AuthOption interface {
+	GitOption
+	HTTPOption
+}
+
+type authHeaderSecret string
+
+func (s authHeaderSecret) SetGitOption(gi *GitInfo) {
+	gi.AuthHeaderSecret = string(s)
+}
+
+func (s authHeaderSecret) SetHTTPOption(hi *HTTPInfo) {
+	hi.AuthHeaderSecret = string(s)
+}
+
+// AuthHeaderSecret sets the ID of the session secret with the value of the
+// Authorization header that is sent to HTTP remotes and URLs. For Git
+// sources it takes precedence over AuthTokenSecret.
+func AuthHeaderSecret(v string) AuthOption {
+	return authHeaderSecret(v)
+}
+
+// KnownSSHHost adds a known_hosts line for verifying the host key of SSH
+// remotes. Host keys are not verified if no known hosts are set.
+func KnownSSHHost(key string) GitOption {
+	key = strings.TrimSuffix(key, "\n")
+	return gitOptionFunc(func(gi *GitInfo) {
+		gi.KnownSSHHosts = gi.KnownSSHHosts + key + "\n"
+	})
+}
+
+// MountSSHSock sets the ID of the SSH agent forwarded by the session that is
+// used to authenticate to SSH remotes
+func MountSSHSock(sshID string) GitOption {
+	return gitOptionFunc(func(gi *GitInfo) {
+		gi.MountSSHSock = sshID
+	})
+}
+
 func Scratch() State {
 	return NewState(nil)
 }
@@ -386,6 +469,14 @@ func HTTP(url string, opts ...HTTPOption) State {
 		attrs[pb.AttrHTTPGID] = strconv.Itoa(hi.GID)
 		addCap(&hi.Constraints, pb.CapSourceHTTPUIDGID)
 	}
+	if hi.AuthHeaderSecret != "" {
+		attrs[pb.AttrHTTPAuthHeaderSecret] = hi.AuthHeaderSecret
+		addCap(&hi.Constraints, pb.CapSourceHTTPAuth)
+	}
+	for k, v := range hi.Header {
+		attrs[pb.AttrHTTPHeaderPrefix+k] = v
+		addCap(&hi.Constraints, pb.CapSourceHTTPHeader)
+	}
 
 	addCap(&hi.Constraints, pb.CapSourceHTTP)
 	source := NewSource(url, attrs, hi.Constraints)
@@ -394,11 +485,13 @@ func HTTP(url string, opts ...HTTPOption) State {
 
 type HTTPInfo struct {
 	constraintsWrapper
-	Checksum digest.Digest
-	Filename string
-	Perm     int
-	UID      int
-	GID      int
+	Checksum         digest.Digest
+	Filename         string
+	Perm             int
+	UID              int
+	GID              int
+	AuthHeaderSecret string
+	Header           map[string]string
 }
 
 type HTTPOption interface {
@@ -436,8 +529,61 @@ func Chown(uid, gid int) HTTPOption {
 	})
 }
 
+// Header sets a header that is sent with the request for the URL. Only the
+// Accept and User-Agent headers are supported.
+func Header(name, value string) HTTPOption {
+	return httpOptionFunc(func(hi *HTTPInfo) {
+		if hi.Header == nil {
+			hi.Header = map[string]string{}
+		}
+		hi.Header[http.CanonicalHeaderKey(name)] = value
+	})
+}
+
+// OCILayout returns the image with the manifest or index dgst from the OCI
+// image layout store storeID. The store is either configured on the daemon
+// or sent by the client in the session of the build.
+func OCILayout(storeID string, dgst digest.Digest, opts ...OCILayoutOption) State {
+	oi := &OCILayoutInfo{}
+	for _, o := range opts {
+		o.SetOCILayoutOption(oi)
+	}
+	attrs := map[string]string{}
+	if oi.SessionID != "" {
+		attrs[pb.AttrOCILayoutSessionID] = oi.SessionID
+	}
+
+	addCap(&oi.Constraints, pb.CapSourceOCILayout)
+
+	source := NewSource("oci-layout://"+storeID+"@"+dgst.String(), attrs, oi.Constraints)
+	return NewState(source.Output())
+}
+
+type OCILayoutOption interface {
+	SetOCILayoutOption(*OCILayoutInfo)
+}
+
+type ociLayoutOptionFunc func(*OCILayoutInfo)
+
+func (fn ociLayoutOptionFunc) SetOCILayoutOption(oi *OCILayoutInfo) {
+	fn(oi)
+}
+
+// OCISessionID sets the session the OCI layout store is read from. It
+// defaults to the session of the build.
+func OCISessionID(id string) OCILayoutOption {
+	return ociLayoutOptionFunc(func(oi *OCILayoutInfo) {
+		oi.SessionID = id
+	})
+}
+
+type OCILayoutInfo struct {
+	constraintsWrapper
+	SessionID string
+}
+
 func platformSpecificSource(id string) bool {
-	return strings.HasPrefix(id, "docker-image://")
+	return strings.HasPrefix(id, "docker-image://") || strings.HasPrefix(id, "oci-layout://")
 }
 
 func addCap(c *Constraints, id apicaps.CapID) {
@@ -0,0 +1,93 @@
+package llb
+
+import (
+	"testing"
+
+	"github.com/moby/buildkit/solver/pb"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOCILayout(t *testing.T) {
+	t.Parallel()
+
+	dgst := digest.FromBytes([]byte("foo"))
+	st := OCILayout("base", dgst, OCISessionID("sess"), LinuxArm64)
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	require.Equal(t, 2, len(arr))
+
+	op := m[arr[1].Inputs[0].Digest]
+	src := op.Op.(*pb.Op_Source).Source
+	require.Equal(t, "oci-layout://base@"+dgst.String(), src.Identifier)
+	require.Equal(t, map[string]string{pb.AttrOCILayoutSessionID: "sess"}, src.Attrs)
+	require.Equal(t, "arm64", op.Platform.Architecture)
+
+	require.True(t, def.Metadata[arr[1].Inputs[0].Digest].Caps[pb.CapSourceOCILayout])
+}
+
+func TestGitAuth(t *testing.T) {
+	t.Parallel()
+
+	st := Git("https://example.com/foo/bar.git", "master", AuthTokenSecret("token"), MountSSHSock("default"), KnownSSHHost("example.com ssh-ed25519 AAAA"))
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	require.Equal(t, 2, len(arr))
+
+	dgst := arr[1].Inputs[0].Digest
+	src := m[dgst].Op.(*pb.Op_Source).Source
+	require.Equal(t, "token", src.Attrs[pb.AttrAuthTokenSecret])
+	require.Equal(t, "default", src.Attrs[pb.AttrMountSSHSock])
+	require.Equal(t, "example.com ssh-ed25519 AAAA\n", src.Attrs[pb.AttrKnownSSHHosts])
+	_, ok := src.Attrs[pb.AttrAuthHeaderSecret]
+	require.False(t, ok)
+
+	require.True(t, def.Metadata[dgst].Caps[pb.CapSourceGitHTTPAuth])
+	require.True(t, def.Metadata[dgst].Caps[pb.CapSourceGitMountSSHSock])
+}
+
+func TestGitSubdir(t *testing.T) {
+	t.Parallel()
+
+	for _, st := range []State{
+		Git("https://github.com/moby/buildkit.git", "v0.3.0:docs"),
+		Git("https://github.com/moby/buildkit.git#v0.3.0:docs", ""),
+	} {
+		def, err := st.Marshal()
+		require.NoError(t, err)
+
+		m, arr := parseDef(t, def.Def)
+		require.Equal(t, 2, len(arr))
+
+		dgst := arr[1].Inputs[0].Digest
+		src := m[dgst].Op.(*pb.Op_Source).Source
+		require.Equal(t, "git://github.com/moby/buildkit.git#v0.3.0:docs", src.Identifier)
+		require.Equal(t, "https://github.com/moby/buildkit.git", src.Attrs[pb.AttrFullRemoteURL])
+		require.True(t, def.Metadata[dgst].Caps[pb.CapSourceGitSubdir])
+	}
+}
+
+func TestHTTPAuth(t *testing.T) {
+	t.Parallel()
+
+	st := HTTP("https://example.com/foo.tar", AuthHeaderSecret("auth"), Header("accept", "application/octet-stream"))
+	def, err := st.Marshal()
+	require.NoError(t, err)
+
+	m, arr := parseDef(t, def.Def)
+	require.Equal(t, 2, len(arr))
+
+	dgst := arr[1].Inputs[0].Digest
+	src := m[dgst].Op.(*pb.Op_Source).Source
+	require.Equal(t, map[string]string{
+		pb.AttrHTTPAuthHeaderSecret:        "auth",
+		pb.AttrHTTPHeaderPrefix + "Accept": "application/octet-stream",
+	}, src.Attrs)
+
+	require.True(t, def.Metadata[dgst].Caps[pb.CapSourceHTTPAuth])
+	require.True(t, def.Metadata[dgst].Caps[pb.CapSourceHTTPHeader])
+}
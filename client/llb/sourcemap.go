@@ -0,0 +1,92 @@
+package llb
+
+import (
+	"github.com/moby/buildkit/solver/pb"
+	digest "github.com/opencontainers/go-digest"
+)
+
+// SourceMap is a file that LLB is generated from, like a Dockerfile. Ops
+// created with its locations can be traced back to the file when they fail.
+type SourceMap struct {
+	Filename string
+	Language string
+	Data     []byte
+}
+
+// NewSourceMap returns a source map for the contents of a file
+func NewSourceMap(filename, language string, dt []byte) *SourceMap {
+	return &SourceMap{
+		Filename: filename,
+		Language: language,
+		Data:     dt,
+	}
+}
+
+// Location marks the ops created with the option as being generated from
+// the ranges of the source file. It is a no-op on a nil SourceMap.
+func (s *SourceMap) Location(r []*pb.Range) ConstraintsOpt {
+	return constraintsOptFunc(func(c *Constraints) {
+		if s == nil {
+			return
+		}
+		c.SourceLocations = append(c.SourceLocations, &SourceLocation{
+			SourceMap: s,
+			Ranges:    r,
+		})
+	})
+}
+
+// SourceLocation is a list of ranges in a source file
+type SourceLocation struct {
+	SourceMap *SourceMap
+	Ranges    []*pb.Range
+}
+
+type sourceMapCollector struct {
+	maps      []*SourceMap
+	index     map[*SourceMap]int
+	locations map[digest.Digest][]*pb.Location
+}
+
+func newSourceMapCollector() *sourceMapCollector {
+	return &sourceMapCollector{
+		index:     map[*SourceMap]int{},
+		locations: map[digest.Digest][]*pb.Location{},
+	}
+}
+
+func (smc *sourceMapCollector) Add(dgst digest.Digest, ls []*SourceLocation) {
+	for _, l := range ls {
+		idx, ok := smc.index[l.SourceMap]
+		if !ok {
+			idx = len(smc.maps)
+			smc.maps = append(smc.maps, l.SourceMap)
+			smc.index[l.SourceMap] = idx
+		}
+		smc.locations[dgst] = append(smc.locations[dgst], &pb.Location{
+			SourceIndex: int32(idx),
+			Ranges:      l.Ranges,
+		})
+	}
+}
+
+// Marshal returns the source maps of the ops or nil if there are none
+func (smc *sourceMapCollector) Marshal() *pb.Source {
+	if len(smc.locations) == 0 {
+		return nil
+	}
+	s := &pb.Source{
+		Locations: make(map[digest.Digest]*pb.Locations, len(smc.locations)),
+	}
+	for dgst, ls := range smc.locations {
+		s.Locations[dgst] = &pb.Locations{Locations: ls}
+	}
+	for _, m := range smc.maps {
+		s.Infos = append(s.Infos, &pb.SourceInfo{
+			Filename: m.Filename,
+			Language: m.Language,
+			Data:     m.Data,
+		})
+	}
+	return s
+}
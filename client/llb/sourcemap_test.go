@@ -0,0 +1,63 @@
+package llb
+
+import (
+	"testing"
+
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceMap(t *testing.T) {
+	t.Parallel()
+
+	sm1 := NewSourceMap("foo", "bar", []byte("data1"))
+	sm2 := NewSourceMap("foo2", "bar2", []byte("data2"))
+
+	rng := func(line int32) []*pb.Range {
+		return []*pb.Range{{Start: pb.Position{Line: line}, End: pb.Position{Line: line}}}
+	}
+
+	st := Image("busybox", sm1.Location(rng(1))).
+		Run(Shlex("true"), sm1.Location(rng(2)), sm2.Location(rng(3))).
+		Run(Shlex("true")).Root()
+
+	def, err := st.Marshal()
+	require.NoError(t, err)
+	require.NotNil(t, def.Source)
+
+	require.Equal(t, 2, len(def.Source.Infos))
+	require.Equal(t, "foo", def.Source.Infos[0].Filename)
+	require.Equal(t, "bar", def.Source.Infos[0].Language)
+	require.Equal(t, []byte("data1"), def.Source.Infos[0].Data)
+	require.Equal(t, "foo2", def.Source.Infos[1].Filename)
+
+	m, arr := parseDef(t, def.Def)
+	require.Equal(t, 4, len(arr))
+
+	last := arr[len(arr)-1].Inputs[0].Digest
+	require.Nil(t, def.Source.Locations[last])
+
+	firstExec := m[last].Inputs[0].Digest
+	locs := def.Source.Locations[firstExec]
+	require.NotNil(t, locs)
+	require.Equal(t, 2, len(locs.Locations))
+	require.Equal(t, int32(0), locs.Locations[0].SourceIndex)
+	require.Equal(t, int32(2), locs.Locations[0].Ranges[0].Start.Line)
+	require.Equal(t, int32(1), locs.Locations[1].SourceIndex)
+	require.Equal(t, int32(3), locs.Locations[1].Ranges[0].Start.Line)
+
+	img := m[firstExec].Inputs[0].Digest
+	locs = def.Source.Locations[img]
+	require.NotNil(t, locs)
+	require.Equal(t, 1, len(locs.Locations))
+	require.Equal(t, int32(1), locs.Locations[0].Ranges[0].Start.Line)
+
+	def, err = Image("busybox").Marshal()
+	require.NoError(t, err)
+	require.Nil(t, def.Source)
+
+	var nilMap *SourceMap
+	def, err = Image("busybox", nilMap.Location(rng(1))).Marshal()
+	require.NoError(t, err)
+	require.Nil(t, def.Source)
+}
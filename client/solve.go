@@ -14,7 +14,9 @@ import (
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/filesync"
 	"github.com/moby/buildkit/session/grpchijack"
+	"github.com/moby/buildkit/solver/errdefs"
 	"github.com/moby/buildkit/solver/pb"
+	spb "github.com/moby/buildkit/sourcepolicy/pb"
 	"github.com/moby/buildkit/util/entitlements"
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
@@ -37,6 +39,18 @@ type SolveOpt struct {
 	ImportCache         []string
 	Session             []session.Attachable
 	AllowedEntitlements []entitlements.Entitlement
+	// Ref identifies the build, for example for CacheExplain. A random
+	// reference is used if it is empty.
+	Ref string
+	// DryRun reports which vertexes would be executed without running the
+	// build or exporting results
+	DryRun bool
+	// SourcePolicy converts or denies the sources of the build. It is applied
+	// before the source policy of the daemon.
+	SourcePolicy *spb.Policy
+	// KeepFailedExec keeps the state of the exec that fails the build so that
+	// DebugShell can start a process in it
+	KeepFailedExec bool
 }
 
 // Solve calls Solve on the controller.
@@ -70,7 +84,10 @@ func (c *Client) solve(ctx context.Context, def *llb.Definition, runGateway runG
 		return nil, err
 	}
 
-	ref := identity.NewID()
+	ref := opt.Ref
+	if ref == "" {
+		ref = identity.NewID()
+	}
 	eg, ctx := errgroup.WithContext(ctx)
 
 	statusContext, cancelStatus := context.WithCancel(context.Background())
@@ -154,13 +171,25 @@ func (c *Client) solve(ctx context.Context, def *llb.Definition, runGateway runG
 				ImportRefs:  opt.ImportCache,
 				ExportAttrs: opt.ExportCacheAttrs,
 			},
-			Entitlements: opt.AllowedEntitlements,
+			Entitlements:   opt.AllowedEntitlements,
+			DryRun:         opt.DryRun,
+			SourcePolicy:   opt.SourcePolicy,
+			KeepFailedExec: opt.KeepFailedExec,
 		})
 		if err != nil {
-			return errors.Wrap(err, "failed to solve")
+			return errors.Wrap(errdefs.FromGRPC(err), "failed to solve")
 		}
 		res = &SolveResponse{
 			ExporterResponse: resp.ExporterResponse,
+			Warnings:         fromControlWarnings(resp.Warnings),
+		}
+		for _, v := range resp.DryRun {
+			res.DryRun = append(res.DryRun, &DryRunVertex{
+				Digest:    v.Digest,
+				Name:      v.Name,
+				Cached:    v.Cached,
+				InputSize: v.InputSize,
+			})
 		}
 		return nil
 	})
@@ -234,6 +263,7 @@ func (c *Client) solve(ctx context.Context, def *llb.Definition, runGateway runG
 					Timestamp: v.Timestamp,
 				})
 			}
+			s.Warnings = fromControlWarnings(resp.Warnings)
 			if statusChan != nil {
 				statusChan <- &s
 			}
@@ -295,3 +325,19 @@ func defaultSessionName() string {
 	}
 	return filepath.Base(wd)
 }
+
+func fromControlWarnings(ws []*controlapi.VertexWarning) []*VertexWarning {
+	var out []*VertexWarning
+	for _, w := range ws {
+		out = append(out, &VertexWarning{
+			Vertex:     w.Vertex,
+			Level:      int(w.Level),
+			Short:      w.Short,
+			Detail:     w.Detail,
+			SourceInfo: w.Info,
+			Range:      w.Ranges,
+			Timestamp:  w.Timestamp,
+		})
+	}
+	return out
+}
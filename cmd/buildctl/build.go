@@ -1,26 +1,38 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/csv"
 	"encoding/json"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/containerd/console"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
 	"github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/client/llb"
+	"github.com/moby/buildkit/identity"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/auth/authprovider"
+	sessioncontent "github.com/moby/buildkit/session/content"
 	"github.com/moby/buildkit/session/secrets/secretsprovider"
 	"github.com/moby/buildkit/session/sshforward/sshprovider"
+	"github.com/moby/buildkit/solver/errdefs"
 	"github.com/moby/buildkit/solver/pb"
+	"github.com/moby/buildkit/sourcepolicy"
+	spb "github.com/moby/buildkit/sourcepolicy/pb"
 	"github.com/moby/buildkit/util/entitlements"
 	"github.com/moby/buildkit/util/progress/progressui"
 	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/tonistiigi/units"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 )
@@ -87,9 +99,38 @@ var buildCommand = cli.Command{
 			Name:  "ssh",
 			Usage: "Allow forwarding SSH agent to the builder. Format default|<id>[=<socket>|<key>[,<key>]]",
 		},
+		cli.StringFlag{
+			Name:  "ref",
+			Usage: "Reference for the build, used by debug commands like cache-explain",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "Show which steps are cached and which would run without building",
+		},
+		cli.StringFlag{
+			Name:  "source-policy-file",
+			Usage: "Read a JSON source policy to allow, deny or convert the sources of the build",
+		},
+		cli.StringSliceFlag{
+			Name:  "oci-layout",
+			Usage: "Allow the build to read images from an OCI layout directory with the oci-layout source. Format name=path",
+		},
+		cli.StringFlag{
+			Name:  "on-error",
+			Usage: "Action when a build step fails (fail, shell). shell starts a debug shell in the state of the failed step",
+			Value: "fail",
+		},
+		cli.BoolFlag{
+			Name:  "print-outline",
+			Usage: "Print the targets and build arguments of the frontend as JSON without building",
+		},
 	},
 }
 
+// requestOutline is the frontend request that returns the targets of the
+// build in the solve response
+const requestOutline = "frontend.outline"
+
 func read(r io.Reader, clicontext *cli.Context) (*llb.Definition, error) {
 	def, err := llb.ReadFrom(r)
 	if err != nil {
@@ -161,11 +202,30 @@ func build(clicontext *cli.Context) error {
 		attachable = append(attachable, secretProvider)
 	}
 
+	if layouts := clicontext.StringSlice("oci-layout"); len(layouts) > 0 {
+		contentProvider, err := parseOCILayoutSpecs(layouts)
+		if err != nil {
+			return err
+		}
+		attachable = append(attachable, contentProvider)
+	}
+
 	allowed, err := parseEntitlements(clicontext.StringSlice("allow"))
 	if err != nil {
 		return err
 	}
 
+	var pol *spb.Policy
+	if fn := clicontext.String("source-policy-file"); fn != "" {
+		dt, err := ioutil.ReadFile(fn)
+		if err != nil {
+			return err
+		}
+		if pol, err = sourcepolicy.ParsePolicy(dt); err != nil {
+			return err
+		}
+	}
+
 	ch := make(chan *client.SolveStatus)
 	eg, ctx := errgroup.WithContext(commandContext(clicontext))
 
@@ -179,11 +239,30 @@ func build(clicontext *cli.Context) error {
 		ImportCache:         clicontext.StringSlice("import-cache"),
 		Session:             attachable,
 		AllowedEntitlements: allowed,
+		Ref:                 clicontext.String("ref"),
+		DryRun:              clicontext.Bool("dry-run"),
+		SourcePolicy:        pol,
+	}
+	switch onError := clicontext.String("on-error"); onError {
+	case "fail":
+	case "shell":
+		solveOpt.KeepFailedExec = true
+		if solveOpt.Ref == "" {
+			// the debug shell is started by the ref of the build
+			solveOpt.Ref = identity.NewID()
+		}
+	default:
+		return errors.Errorf("invalid on-error value: %s", onError)
 	}
 	solveOpt.ExporterAttrs, err = attrMap(clicontext.StringSlice("exporter-opt"))
 	if err != nil {
 		return errors.Wrap(err, "invalid exporter-opt")
 	}
+	if solveOpt.DryRun {
+		// nothing is exported in a dry run
+		solveOpt.Exporter = ""
+		solveOpt.ExportCache = ""
+	}
 	solveOpt.ExporterOutput, solveOpt.ExporterOutputDir, err = resolveExporterOutput(solveOpt.Exporter, solveOpt.ExporterAttrs["output"])
 	if err != nil {
 		return errors.Wrap(err, "invalid exporter-opt: output")
@@ -223,6 +302,19 @@ func build(clicontext *cli.Context) error {
 		}
 	}
 
+	printOutline := clicontext.Bool("print-outline")
+	if printOutline {
+		if def != nil {
+			return errors.New("print-outline requires a frontend")
+		}
+		if solveOpt.Exporter != "" {
+			return errors.New("print-outline can't be used with an exporter")
+		}
+		solveOpt.FrontendAttrs["requestid"] = requestOutline
+	}
+
+	var dryRun []*client.DryRunVertex
+	var outline string
 	eg.Go(func() error {
 		resp, err := c.Solve(ctx, def, solveOpt, ch)
 		if err != nil {
@@ -231,6 +323,8 @@ func build(clicontext *cli.Context) error {
 		for k, v := range resp.ExporterResponse {
 			logrus.Debugf("solve response: %s=%s", k, v)
 		}
+		dryRun = resp.DryRun
+		outline = resp.ExporterResponse[requestOutline]
 		return err
 	})
 
@@ -265,11 +359,62 @@ func build(clicontext *cli.Context) error {
 			return errors.Errorf("invalid progress value : %s", progressOpt)
 		}
 
+		// keep stdout for the outline
+		var w io.Writer = os.Stdout
+		if printOutline {
+			w = os.Stderr
+		}
+
 		// not using shared context to not disrupt display but let is finish reporting errors
-		return progressui.DisplaySolveStatus(context.TODO(), "", c, os.Stdout, displayCh)
+		return progressui.DisplaySolveStatus(context.TODO(), "", c, w, displayCh)
 	})
 
-	return eg.Wait()
+	if err := eg.Wait(); err != nil {
+		for _, src := range errdefs.Sources(err) {
+			src.Print(os.Stderr)
+		}
+		if solveOpt.KeepFailedExec {
+			fmt.Fprintf(os.Stderr, "error: %v\nstarting debug shell in the failed step\n", err)
+			if err := debugShell(commandContext(clicontext), c, solveOpt.Ref, def != nil); err != nil {
+				logrus.Errorf("debug shell: %v", err)
+			}
+		}
+		return err
+	}
+
+	if solveOpt.DryRun {
+		printDryRun(dryRun)
+	}
+	if printOutline {
+		return writeOutline(outline)
+	}
+	return nil
+}
+
+func writeOutline(dt string) error {
+	if dt == "" {
+		return errors.New("frontend returned no outline")
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(dt), "", "  "); err != nil {
+		return errors.Wrap(err, "invalid outline")
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(os.Stdout)
+	return err
+}
+
+func printDryRun(vtxs []*client.DryRunVertex) {
+	tw := tabwriter.NewWriter(os.Stdout, 1, 8, 1, '\t', 0)
+	fmt.Fprintln(tw, "VERTEX\tSTATUS\tINPUT SIZE\tNAME")
+	for _, v := range vtxs {
+		status := "uncached"
+		if v.Cached {
+			status = "cached"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%.2f\t%s\n", v.Digest, status, units.Bytes(v.InputSize), v.Name)
+	}
+	tw.Flush()
 }
 
 func attrMap(sl []string) (map[string]string, error) {
@@ -334,6 +479,22 @@ func parseSecret(value string) (*secretsprovider.FileSource, error) {
 	return &fs, nil
 }
 
+func parseOCILayoutSpecs(sl []string) (session.Attachable, error) {
+	stores := map[string]content.Store{}
+	for _, v := range sl {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid oci-layout %q, expected name=path", v)
+		}
+		store, err := local.NewStore(parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open oci layout %s", parts[1])
+		}
+		stores[parts[0]] = store
+	}
+	return sessioncontent.NewAttachable(stores), nil
+}
+
 // resolveExporterOutput returns at most either one of io.WriteCloser (single file) or a string (directory path).
 func resolveExporterOutput(exporter, output string) (io.WriteCloser, string, error) {
 	switch exporter {
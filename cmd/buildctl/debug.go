@@ -12,5 +12,6 @@ var debugCommand = cli.Command{
 		debug.DumpLLBCommand,
 		debug.DumpMetadataCommand,
 		debug.WorkersCommand,
+		debug.CacheExplainCommand,
 	},
 }
@@ -0,0 +1,68 @@
+package debug
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var CacheExplainCommand = cli.Command{
+	Name:      "cache-explain",
+	Usage:     "explain why the steps of a recent build did or did not use the cache",
+	ArgsUsage: "<build-ref>",
+	Action:    cacheExplain,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "verbose, v",
+			Usage: "Show the cache keys of the inputs",
+		},
+	},
+}
+
+func cacheExplain(clicontext *cli.Context) error {
+	if clicontext.NArg() != 1 {
+		return errors.New("build reference required")
+	}
+
+	c, err := resolveClient(clicontext)
+	if err != nil {
+		return err
+	}
+
+	exps, err := c.CacheExplain(commandContext(clicontext), clicontext.Args().First())
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 1, 8, 1, '\t', 0)
+	verbose := clicontext.Bool("verbose")
+	for _, ex := range exps {
+		fmt.Fprintf(tw, "%s\t%s\n", ex.Vertex, ex.Name)
+		if ex.Cached {
+			fmt.Fprintf(tw, "\tCached:\ttrue\n")
+		} else {
+			fmt.Fprintf(tw, "\tCached:\tfalse\n")
+			fmt.Fprintf(tw, "\tReason:\t%s\n", ex.Reason)
+		}
+		for i, inp := range ex.Inputs {
+			if !verbose && !inp.Changed {
+				continue
+			}
+			status := "unchanged"
+			if inp.Changed {
+				status = "changed"
+			}
+			fmt.Fprintf(tw, "\tInput %d:\t%s\n", i, status)
+			if verbose {
+				for _, k := range inp.Keys {
+					fmt.Fprintf(tw, "\t\t%s\n", k)
+				}
+			}
+		}
+		fmt.Fprintf(tw, "\n")
+	}
+	return tw.Flush()
+}
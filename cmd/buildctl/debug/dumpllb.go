@@ -111,6 +111,27 @@ func attr(dgst digest.Digest, op pb.Op) (string, string) {
 		return strings.Join(op.Exec.Meta.Args, " "), "box"
 	case *pb.Op_Build:
 		return "build", "box3d"
+	case *pb.Op_File:
+		names := []string{}
+		for _, action := range op.File.Actions {
+			var name string
+			switch a := action.Action.(type) {
+			case *pb.FileAction_Copy:
+				name = fmt.Sprintf("copy{src=%s, dest=%s}", a.Copy.Src, a.Copy.Dest)
+			case *pb.FileAction_Mkfile:
+				name = fmt.Sprintf("mkfile{path=%s}", a.Mkfile.Path)
+			case *pb.FileAction_Mkdir:
+				name = fmt.Sprintf("mkdir{path=%s}", a.Mkdir.Path)
+			case *pb.FileAction_Rm:
+				name = fmt.Sprintf("rm{path=%s}", a.Rm.Path)
+			}
+			names = append(names, name)
+		}
+		return strings.Join(names, ","), "note"
+	case *pb.Op_Merge:
+		return "merge", "invhouse"
+	case *pb.Op_Diff:
+		return "diff", "house"
 	default:
 		return dgst.String(), "plaintext"
 	}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/containerd/console"
+	"github.com/moby/buildkit/client"
+	"github.com/pkg/errors"
+)
+
+// debugShell starts a shell in the state of the failed step of the build ref.
+// If the definition of the build was read from stdin the shell reads from
+// the controlling terminal instead.
+func debugShell(ctx context.Context, c *client.Client, ref string, stdinUsed bool) error {
+	stdin := os.Stdin
+	if stdinUsed {
+		f, err := os.Open(ttyPath)
+		if err != nil {
+			return errors.Wrap(err, "stdin was used for the definition and there is no terminal for the shell")
+		}
+		defer f.Close()
+		stdin = f
+	}
+
+	opt := client.DebugShellOpt{
+		Args:   []string{"/bin/sh"},
+		Stdin:  stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+	}
+
+	if con, err := console.ConsoleFromFile(stdin); err == nil {
+		if err := con.SetRaw(); err != nil {
+			return err
+		}
+		defer con.Reset()
+
+		resize := make(chan client.WinSize, 1)
+		sendSize := func() {
+			ws, err := con.Size()
+			if err != nil {
+				return
+			}
+			select {
+			case resize <- client.WinSize{Rows: uint32(ws.Height), Cols: uint32(ws.Width)}:
+			default:
+			}
+		}
+		sendSize()
+		stop := notifyResize(sendSize)
+		defer stop()
+
+		opt.Tty = true
+		opt.Resize = resize
+	}
+
+	return c.DebugShell(ctx, ref, opt)
+}
@@ -0,0 +1,9 @@
+package main
+
+const ttyPath = "CONIN$"
+
+// notifyResize is a no-op on Windows, the size of the terminal is only sent
+// when the shell is started
+func notifyResize(fn func()) func() {
+	return func() {}
+}
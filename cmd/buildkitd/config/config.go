@@ -24,6 +24,18 @@ type Config struct {
 	} `toml:"worker"`
 
 	Registries map[string]RegistryConfig `toml:"registry"`
+
+	// Entitlements are the insecure entitlements that builds are allowed to
+	// request, e.g. network.host or security.unconfined
+	Entitlements []string `toml:"insecure-entitlements"`
+
+	// SourcePolicy is the path to a JSON source policy that is applied to the
+	// sources of all builds
+	SourcePolicy string `toml:"source-policy"`
+
+	// OCILayouts are OCI image layout directories by store ID that builds
+	// can use with the oci-layout source without sending them in a session
+	OCILayouts map[string]string `toml:"oci-layouts"`
 }
 
 type GRPCConfig struct {
@@ -55,6 +67,9 @@ type OCIConfig struct {
 	Snapshotter string            `toml:"snapshotter"`
 	Rootless    bool              `toml:"rootless"`
 	GCPolicy    []GCPolicy        `toml:"gcpolicy"`
+	Resources   ResourceLimits    `toml:"resources"`
+	// Timeout is the default timeout for each vertex in seconds
+	Timeout int64 `toml:"timeout"`
 }
 
 type ContainerdConfig struct {
@@ -64,6 +79,9 @@ type ContainerdConfig struct {
 	Platforms []string          `toml:"platforms"`
 	GCPolicy  []GCPolicy        `toml:"gcpolicy"`
 	Namespace string            `toml:"namespace"`
+	Resources ResourceLimits    `toml:"resources"`
+	// Timeout is the default timeout for each vertex in seconds
+	Timeout int64 `toml:"timeout"`
 }
 
 type GCPolicy struct {
@@ -73,6 +91,16 @@ type GCPolicy struct {
 	Filters      []string `toml:"filters"`
 }
 
+// ResourceLimits are the default cgroup limits for processes run by a worker.
+// Zero values are unset.
+type ResourceLimits struct {
+	CPUShares uint64 `toml:"cpuShares"`
+	CPUQuota  int64  `toml:"cpuQuota"`
+	CPUPeriod uint64 `toml:"cpuPeriod"`
+	Memory    int64  `toml:"memory"`
+	Pids      int64  `toml:"pids"`
+}
+
 func Load(r io.Reader) (Config, *toml.MetaData, error) {
 	var c Config
 	md, err := toml.DecodeReader(r, &c)
@@ -12,6 +12,8 @@ func TestConfig(t *testing.T) {
 	const testConfig = `
 root = "/foo/bar"
 debug=true
+insecure-entitlements = ["security.unconfined"]
+source-policy = "/etc/buildkit/policy.json"
 
 [grpc]
 address=["buildkit.sock"]
@@ -24,6 +26,10 @@ cert="mycert.pem"
 enabled=true
 snapshotter="overlay"
 rootless=true
+timeout=3600
+[worker.oci.resources]
+memory=1073741824
+pids=100
 [worker.oci.labels]
 foo="bar"
 "aa.bb.cc"="baz"
@@ -44,6 +50,9 @@ keepDuration=7200
 [registry."docker.io"]
 mirrors=["hub.docker.io"]
 http=true
+
+[oci-layouts]
+base="/var/lib/buildkit-layouts/base"
 `
 
 	cfg, md, err := Load(bytes.NewBuffer([]byte(testConfig)))
@@ -51,6 +60,9 @@ http=true
 
 	require.Equal(t, "/foo/bar", cfg.Root)
 	require.Equal(t, true, cfg.Debug)
+	require.Equal(t, []string{"security.unconfined"}, cfg.Entitlements)
+	require.Equal(t, "/etc/buildkit/policy.json", cfg.SourcePolicy)
+	require.Equal(t, map[string]string{"base": "/var/lib/buildkit-layouts/base"}, cfg.OCILayouts)
 
 	require.Equal(t, "buildkit.sock", cfg.GRPC.Address[0])
 	require.Equal(t, "debug.sock", cfg.GRPC.DebugAddress)
@@ -68,6 +80,12 @@ http=true
 	require.Equal(t, "bar", cfg.Workers.OCI.Labels["foo"])
 	require.Equal(t, "baz", cfg.Workers.OCI.Labels["aa.bb.cc"])
 
+	require.Equal(t, int64(1073741824), cfg.Workers.OCI.Resources.Memory)
+	require.Equal(t, int64(100), cfg.Workers.OCI.Resources.Pids)
+	require.Equal(t, uint64(0), cfg.Workers.OCI.Resources.CPUShares)
+	require.Equal(t, int64(3600), cfg.Workers.OCI.Timeout)
+	require.Equal(t, int64(0), cfg.Workers.Containerd.Timeout)
+
 	require.Nil(t, cfg.Workers.Containerd.Enabled)
 	require.Equal(t, 1, len(cfg.Workers.Containerd.Platforms))
 	require.Equal(t, "containerd.sock", cfg.Workers.Containerd.Address)
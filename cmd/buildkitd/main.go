@@ -16,6 +16,8 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
 	"github.com/containerd/containerd/pkg/seed"
 	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/sys"
@@ -31,6 +33,8 @@ import (
 	"github.com/moby/buildkit/frontend/gateway/forwarder"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/solver/bboltcachestorage"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/moby/buildkit/sourcepolicy"
 	"github.com/moby/buildkit/util/apicaps"
 	"github.com/moby/buildkit/util/appcontext"
 	"github.com/moby/buildkit/util/appdefaults"
@@ -56,6 +60,8 @@ func init() {
 type workerInitializerOpt struct {
 	sessionManager *session.Manager
 	config         *config.Config
+	sourcePolicy   *sourcepolicy.Engine
+	ociStores      map[string]content.Store
 }
 
 type workerInitializer struct {
@@ -153,6 +159,18 @@ func main() {
 			Usage: "ca certificate to verify clients",
 			Value: defaultConf.GRPC.TLS.CA,
 		},
+		cli.StringSliceFlag{
+			Name:  "allow-insecure-entitlement",
+			Usage: "allows insecure entitlements e.g. network.host, security.unconfined",
+		},
+		cli.StringFlag{
+			Name:  "source-policy",
+			Usage: "JSON source policy file to allow, deny or convert the sources of all builds",
+		},
+		cli.StringSliceFlag{
+			Name:  "oci-layout",
+			Usage: "OCI image layout directory builds can read with the oci-layout source, name=path",
+		},
 	)
 	app.Flags = append(app.Flags, appFlags...)
 
@@ -369,6 +387,26 @@ func applyMainFlags(c *cli.Context, cfg *config.Config, md *toml.MetaData) error
 	if tlsca := c.String("tlsca"); tlsca != "" {
 		cfg.GRPC.TLS.CA = tlsca
 	}
+
+	if c.IsSet("allow-insecure-entitlement") {
+		// override values from config
+		cfg.Entitlements = c.StringSlice("allow-insecure-entitlement")
+	}
+
+	if sourcePolicy := c.String("source-policy"); sourcePolicy != "" {
+		cfg.SourcePolicy = sourcePolicy
+	}
+
+	for _, v := range c.StringSlice("oci-layout") {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return errors.Errorf("invalid oci-layout %q, expected name=path", v)
+		}
+		if cfg.OCILayouts == nil {
+			cfg.OCILayouts = map[string]string{}
+		}
+		cfg.OCILayouts[parts[0]] = parts[1]
+	}
 	return nil
 }
 
@@ -489,9 +527,19 @@ func newController(c *cli.Context, cfg *config.Config) (*control.Controller, err
 	if err != nil {
 		return nil, err
 	}
+	sourcePolicy, err := loadSourcePolicy(cfg.SourcePolicy)
+	if err != nil {
+		return nil, err
+	}
+	ociStores, err := loadOCIStores(cfg.OCILayouts)
+	if err != nil {
+		return nil, err
+	}
 	wc, err := newWorkerController(c, workerInitializerOpt{
 		sessionManager: sessionManager,
 		config:         cfg,
+		sourcePolicy:   sourcePolicy,
+		ociStores:      ociStores,
 	})
 	if err != nil {
 		return nil, err
@@ -515,9 +563,37 @@ func newController(c *cli.Context, cfg *config.Config) (*control.Controller, err
 		ResolveCacheExporterFunc: registryremotecache.ResolveCacheExporterFunc(sessionManager, resolverFn),
 		ResolveCacheImporterFunc: registryremotecache.ResolveCacheImporterFunc(sessionManager, resolverFn),
 		CacheKeyStorage:          cacheStorage,
+		Entitlements:             cfg.Entitlements,
 	})
 }
 
+func loadSourcePolicy(fn string) (*sourcepolicy.Engine, error) {
+	if fn == "" {
+		return nil, nil
+	}
+	dt, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read source policy")
+	}
+	pol, err := sourcepolicy.ParsePolicy(dt)
+	if err != nil {
+		return nil, err
+	}
+	return sourcepolicy.NewEngine("buildkitd", pol)
+}
+
+func loadOCIStores(layouts map[string]string) (map[string]content.Store, error) {
+	stores := map[string]content.Store{}
+	for name, p := range layouts {
+		store, err := local.NewStore(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open oci layout %s", p)
+		}
+		stores[name] = store
+	}
+	return stores, nil
+}
+
 func resolverFunc(cfg *config.Config) resolver.ResolveOptionsFunc {
 	m := map[string]resolver.RegistryConf{}
 	for k, v := range cfg.Registries {
@@ -589,6 +665,19 @@ func parsePlatforms(platformsStr []string) ([]specs.Platform, error) {
 	return out, nil
 }
 
+func getResourceLimits(cfg config.ResourceLimits) *pb.ResourceLimits {
+	if cfg == (config.ResourceLimits{}) {
+		return nil
+	}
+	return &pb.ResourceLimits{
+		CpuShares: cfg.CPUShares,
+		CpuQuota:  cfg.CPUQuota,
+		CpuPeriod: cfg.CPUPeriod,
+		Memory:    cfg.Memory,
+		Pids:      cfg.Pids,
+	}
+}
+
 func getGCPolicy(rules []config.GCPolicy, root string) []client.PruneInfo {
 	if len(rules) == 0 {
 		rules = config.DefaultGCPolicy(root)
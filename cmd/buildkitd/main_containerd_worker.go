@@ -136,13 +136,16 @@ func containerdWorkerInitializer(c *cli.Context, common workerInitializerOpt) ([
 		return nil, nil
 	}
 
-	opt, err := containerd.NewWorkerOpt(common.config.Root, cfg.Address, ctd.DefaultSnapshotter, cfg.Namespace, cfg.Labels, ctd.WithTimeout(60*time.Second))
+	opt, err := containerd.NewWorkerOpt(common.config.Root, cfg.Address, ctd.DefaultSnapshotter, cfg.Namespace, cfg.Labels, getResourceLimits(cfg.Resources), ctd.WithTimeout(60*time.Second))
 	if err != nil {
 		return nil, err
 	}
 	opt.SessionManager = common.sessionManager
 	opt.GCPolicy = getGCPolicy(cfg.GCPolicy, common.config.Root)
+	opt.DefaultTimeout = time.Duration(cfg.Timeout) * time.Second
 	opt.ResolveOptionsFunc = resolverFunc(common.config)
+	opt.SourcePolicy = common.sourcePolicy
+	opt.OCIStores = common.ociStores
 
 	if platformsStr := cfg.Platforms; len(platformsStr) != 0 {
 		platforms, err := parsePlatforms(platformsStr)
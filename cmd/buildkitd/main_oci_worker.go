@@ -5,6 +5,7 @@ package main
 import (
 	"os/exec"
 	"strconv"
+	"time"
 
 	ctdsnapshot "github.com/containerd/containerd/snapshots"
 	"github.com/containerd/containerd/snapshots/native"
@@ -136,13 +137,16 @@ func ociWorkerInitializer(c *cli.Context, common workerInitializerOpt) ([]worker
 	if cfg.Rootless {
 		logrus.Debugf("running in rootless mode")
 	}
-	opt, err := runc.NewWorkerOpt(common.config.Root, snFactory, cfg.Rootless, cfg.Labels)
+	opt, err := runc.NewWorkerOpt(common.config.Root, snFactory, cfg.Rootless, cfg.Labels, getResourceLimits(cfg.Resources))
 	if err != nil {
 		return nil, err
 	}
 	opt.SessionManager = common.sessionManager
 	opt.GCPolicy = getGCPolicy(cfg.GCPolicy, common.config.Root)
+	opt.DefaultTimeout = time.Duration(cfg.Timeout) * time.Second
 	opt.ResolveOptionsFunc = resolverFunc(common.config)
+	opt.SourcePolicy = common.sourcePolicy
+	opt.OCIStores = common.ociStores
 
 	if platformsStr := cfg.Platforms; len(platformsStr) != 0 {
 		platforms, err := parsePlatforms(platformsStr)
@@ -16,6 +16,7 @@ import (
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/grpchijack"
 	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/errdefs"
 	"github.com/moby/buildkit/solver/llbsolver"
 	"github.com/moby/buildkit/solver/pb"
 	"github.com/moby/buildkit/util/throttle"
@@ -35,6 +36,7 @@ type Opt struct {
 	CacheKeyStorage          solver.CacheKeyStorage
 	ResolveCacheExporterFunc remotecache.ResolveCacheExporterFunc
 	ResolveCacheImporterFunc remotecache.ResolveCacheImporterFunc
+	Entitlements             []string
 }
 
 type Controller struct { // TODO: ControlService
@@ -51,7 +53,7 @@ func NewController(opt Opt) (*Controller, error) {
 
 	gatewayForwarder := controlgateway.NewGatewayForwarder()
 
-	solver, err := llbsolver.New(opt.WorkerController, opt.Frontends, cache, opt.ResolveCacheImporterFunc, gatewayForwarder)
+	solver, err := llbsolver.New(opt.WorkerController, opt.Frontends, cache, opt.ResolveCacheImporterFunc, gatewayForwarder, opt.Entitlements)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create solver")
 	}
@@ -186,6 +188,10 @@ func (c *Controller) Solve(ctx context.Context, req *controlapi.SolveRequest) (*
 		time.AfterFunc(time.Second, c.throttledGC)
 	}()
 
+	if req.DryRun {
+		return c.dryRun(ctx, req)
+	}
+
 	var expi exporter.ExporterInstance
 	// TODO: multiworker
 	// This is actually tricky, as the exporter should come from the worker that has the returned reference. We may need to delay this so that the solver loads this.
@@ -236,15 +242,48 @@ func (c *Controller) Solve(ctx context.Context, req *controlapi.SolveRequest) (*
 		Exporter:        expi,
 		CacheExporter:   cacheExporter,
 		CacheExportMode: parseCacheExporterOpt(req.Cache.ExportAttrs),
-	}, req.Entitlements)
+	}, req.Entitlements, req.SourcePolicy, req.KeepFailedExec)
 	if err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(err)
 	}
 	return &controlapi.SolveResponse{
 		ExporterResponse: resp.ExporterResponse,
+		Warnings:         toControlWarnings(resp.Warnings),
 	}, nil
 }
 
+func (c *Controller) dryRun(ctx context.Context, req *controlapi.SolveRequest) (*controlapi.SolveResponse, error) {
+	var importCacheRefs []string
+	for _, ref := range req.Cache.ImportRefs {
+		parsed, err := reference.ParseNormalizedNamed(ref)
+		if err != nil {
+			return nil, err
+		}
+		importCacheRefs = append(importCacheRefs, reference.TagNameOnly(parsed).String())
+	}
+
+	vtxs, err := c.solver.DryRun(ctx, req.Ref, frontend.SolveRequest{
+		Frontend:        req.Frontend,
+		Definition:      req.Definition,
+		FrontendOpt:     req.FrontendAttrs,
+		ImportCacheRefs: importCacheRefs,
+	}, req.Entitlements, req.SourcePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &controlapi.SolveResponse{}
+	for _, v := range vtxs {
+		resp.DryRun = append(resp.DryRun, &controlapi.DryRunVertex{
+			Digest:    v.Vertex,
+			Name:      v.Name,
+			Cached:    v.Cached,
+			InputSize: v.InputSize,
+		})
+	}
+	return resp, nil
+}
+
 func (c *Controller) Status(req *controlapi.StatusRequest, stream controlapi.Control_StatusServer) error {
 	ch := make(chan *client.SolveStatus, 8)
 
@@ -291,6 +330,7 @@ func (c *Controller) Status(req *controlapi.StatusRequest, stream controlapi.Con
 					Timestamp: v.Timestamp,
 				})
 			}
+			sr.Warnings = toControlWarnings(ss.Warnings)
 			if err := stream.SendMsg(&sr); err != nil {
 				return err
 			}
@@ -300,6 +340,22 @@ func (c *Controller) Status(req *controlapi.StatusRequest, stream controlapi.Con
 	return eg.Wait()
 }
 
+func toControlWarnings(ws []*client.VertexWarning) []*controlapi.VertexWarning {
+	var out []*controlapi.VertexWarning
+	for _, w := range ws {
+		out = append(out, &controlapi.VertexWarning{
+			Vertex:    w.Vertex,
+			Level:     int64(w.Level),
+			Short:     w.Short,
+			Detail:    w.Detail,
+			Info:      w.SourceInfo,
+			Ranges:    w.Range,
+			Timestamp: w.Timestamp,
+		})
+	}
+	return out
+}
+
 func (c *Controller) Session(stream controlapi.Control_SessionServer) error {
 	logrus.Debugf("session started")
 	conn, closeCh, opts := grpchijack.Hijack(stream)
@@ -333,6 +389,31 @@ func (c *Controller) ListWorkers(ctx context.Context, r *controlapi.ListWorkersR
 	return resp, nil
 }
 
+func (c *Controller) CacheExplain(ctx context.Context, r *controlapi.CacheExplainRequest) (*controlapi.CacheExplainResponse, error) {
+	exp, err := c.solver.CacheExplanations(r.Ref)
+	if err != nil {
+		return nil, err
+	}
+	resp := &controlapi.CacheExplainResponse{}
+	for _, ex := range exp {
+		e := &controlapi.CacheExplanation{
+			Vertex: ex.Vertex,
+			Name:   ex.Name,
+			Output: int64(ex.Output),
+			Cached: ex.Cached,
+			Reason: ex.Reason,
+		}
+		for _, inp := range ex.Inputs {
+			e.Inputs = append(e.Inputs, &controlapi.CacheExplanationInput{
+				Keys:    inp.Keys,
+				Changed: inp.Changed,
+			})
+		}
+		resp.Explanations = append(resp.Explanations, e)
+	}
+	return resp, nil
+}
+
 func (c *Controller) gc() {
 	c.gcmu.Lock()
 	defer c.gcmu.Unlock()
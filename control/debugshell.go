@@ -0,0 +1,90 @@
+package control
+
+import (
+	"sync"
+
+	controlapi "github.com/moby/buildkit/api/services/control"
+	"github.com/moby/buildkit/executor"
+	"github.com/moby/buildkit/util/iostream"
+	"github.com/pkg/errors"
+)
+
+func (c *Controller) DebugShell(stream controlapi.Control_DebugShellServer) error {
+	ctx := stream.Context()
+
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	init := msg.GetInit()
+	if init == nil {
+		return errors.Errorf("first debug shell message needs to be init")
+	}
+	if len(init.Args) == 0 {
+		return errors.Errorf("no command for debug shell")
+	}
+
+	stdinR, stdinW, err := iostream.StdinPipe()
+	if err != nil {
+		return err
+	}
+	defer stdinR.Close()
+
+	resize := make(chan executor.WinSize, 1)
+	go func() {
+		defer stdinW.Close()
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			switch r := msg.Request.(type) {
+			case *controlapi.DebugShellRequest_Stdin:
+				if _, err := stdinW.Write(r.Stdin); err != nil {
+					return
+				}
+			case *controlapi.DebugShellRequest_CloseStdin:
+				stdinW.Close()
+			case *controlapi.DebugShellRequest_Resize:
+				// only shells with a terminal are resized
+				if r.Resize == nil || !init.Tty {
+					continue
+				}
+				select {
+				case resize <- executor.WinSize{Rows: r.Resize.Rows, Cols: r.Resize.Cols}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	var mu sync.Mutex
+	send := func(resp *controlapi.DebugShellResponse) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return stream.Send(resp)
+	}
+
+	err = c.solver.DebugShell(ctx, init.Ref, executor.ProcessInfo{
+		Meta: executor.Meta{
+			Args: init.Args,
+			Env:  init.Env,
+			Tty:  init.Tty,
+		},
+		Stdin: stdinR,
+		Stdout: iostream.NewWriter(func(dt []byte) error {
+			return send(&controlapi.DebugShellResponse{Response: &controlapi.DebugShellResponse_Stdout{Stdout: dt}})
+		}),
+		Stderr: iostream.NewWriter(func(dt []byte) error {
+			return send(&controlapi.DebugShellResponse{Response: &controlapi.DebugShellResponse_Stderr{Stderr: dt}})
+		}),
+		Resize: resize,
+	})
+
+	exit := &controlapi.DebugShellExit{}
+	if err != nil {
+		exit.Error = err.Error()
+	}
+	return send(&controlapi.DebugShellResponse{Response: &controlapi.DebugShellResponse_Exit{Exit: exit}})
+}
@@ -2,7 +2,7 @@ package containerdexecutor
 
 import (
 	"context"
-	"io"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"syscall"
@@ -29,19 +29,23 @@ type containerdExecutor struct {
 	root             string
 	networkProviders map[pb.NetMode]network.Provider
 	cgroupParent     string
+	resourceLimits   *pb.ResourceLimits
 }
 
-// New creates a new executor backed by connection to containerd API
-func New(client *containerd.Client, root, cgroup string, networkProviders map[pb.NetMode]network.Provider) executor.Executor {
+// New creates a new executor backed by connection to containerd API.
+// resourceLimits are applied to processes that don't set their own.
+func New(client *containerd.Client, root, cgroup string, resourceLimits *pb.ResourceLimits, networkProviders map[pb.NetMode]network.Provider) executor.Executor {
 	return containerdExecutor{
 		client:           client,
 		root:             root,
 		networkProviders: networkProviders,
 		cgroupParent:     cgroup,
+		resourceLimits:   resourceLimits,
 	}
 }
 
-func (w containerdExecutor) Exec(ctx context.Context, meta executor.Meta, root cache.Mountable, mounts []executor.Mount, stdin io.ReadCloser, stdout, stderr io.WriteCloser) (err error) {
+func (w containerdExecutor) Exec(ctx context.Context, root cache.Mountable, mounts []executor.Mount, process executor.ProcessInfo) (err error) {
+	meta := process.Meta
 	id := identity.NewID()
 
 	resolvConf, err := oci.GetResolvConf(ctx, w.root)
@@ -102,7 +106,9 @@ func (w containerdExecutor) Exec(ctx context.Context, meta executor.Meta, root c
 	if meta.ReadonlyRootFS {
 		opts = append(opts, containerdoci.WithRootFSReadonly())
 	}
-	if system.SeccompSupported() {
+	if meta.SecurityMode == pb.SecurityMode_INSECURE {
+		opts = append(opts, oci.WithInsecureSpec())
+	} else if system.SeccompSupported() {
 		opts = append(opts, seccomp.WithDefaultProfile())
 	}
 	if w.cgroupParent != "" {
@@ -115,6 +121,8 @@ func (w containerdExecutor) Exec(ctx context.Context, meta executor.Meta, root c
 		}
 		opts = append(opts, containerdoci.WithCgroup(cgroupsPath))
 	}
+	limits := oci.MergeResourceLimits(meta.ResourceLimits, w.resourceLimits)
+	opts = append(opts, oci.WithResourceLimits(limits))
 	spec, cleanup, err := oci.GenerateSpec(ctx, meta, mounts, id, resolvConf, hostsFile, namespace, opts...)
 	if err != nil {
 		return err
@@ -134,7 +142,12 @@ func (w containerdExecutor) Exec(ctx context.Context, meta executor.Meta, root c
 		}
 	}()
 
-	task, err := container.NewTask(ctx, cio.NewCreator(cio.WithStreams(stdin, stdout, stderr)), containerd.WithRootFS(rootMounts))
+	cioOpts := []cio.Opt{cio.WithStreams(process.Stdin, process.Stdout, process.Stderr)}
+	if meta.Tty {
+		cioOpts = append(cioOpts, cio.WithTerminal)
+	}
+
+	task, err := container.NewTask(ctx, cio.NewCreator(cioOpts...), containerd.WithRootFS(rootMounts))
 	if err != nil {
 		return err
 	}
@@ -144,6 +157,19 @@ func (w containerdExecutor) Exec(ctx context.Context, meta executor.Meta, root c
 		}
 	}()
 
+	// subscribe before starting the task so that no OOM kill is missed
+	oomKilled := make(chan struct{})
+	oomCtx, cancelOOM := context.WithCancel(ctx)
+	defer cancelOOM()
+	oomEvents, oomErrs := w.client.Subscribe(oomCtx, fmt.Sprintf("topic==%q,event.container_id==%q", "/tasks/oom", id))
+	go func() {
+		select {
+		case <-oomEvents:
+			close(oomKilled)
+		case <-oomErrs:
+		}
+	}()
+
 	if err := task.Start(ctx); err != nil {
 		return err
 	}
@@ -154,19 +180,40 @@ func (w containerdExecutor) Exec(ctx context.Context, meta executor.Meta, root c
 	}
 
 	var cancel func()
+	var killTimer <-chan time.Time
 	ctxDone := ctx.Done()
 	for {
 		select {
 		case <-ctxDone:
 			ctxDone = nil
+			if ctx.Err() == context.DeadlineExceeded {
+				// on timeout the process gets a chance to exit cleanly
+				termCtx, termCancel := context.WithTimeout(context.Background(), 10*time.Second)
+				task.Kill(termCtx, syscall.SIGTERM)
+				termCancel()
+				killTimer = time.After(executor.KillGracePeriod)
+				continue
+			}
 			var killCtx context.Context
 			killCtx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
 			task.Kill(killCtx, syscall.SIGKILL)
+		case <-killTimer:
+			killTimer = nil
+			var killCtx context.Context
+			killCtx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+			task.Kill(killCtx, syscall.SIGKILL)
+		case ws := <-process.Resize:
+			if err := task.Resize(ctx, ws.Cols, ws.Rows); err != nil {
+				logrus.Errorf("failed to resize terminal of %s: %v", id, err)
+			}
 		case status := <-statusCh:
 			if cancel != nil {
 				cancel()
 			}
 			if status.ExitCode() != 0 {
+				if err := oci.OOMError(int(status.ExitCode()), limits, oomKilled); err != nil {
+					return err
+				}
 				return errors.Errorf("process returned non-zero exit code: %d", status.ExitCode())
 			}
 			return nil
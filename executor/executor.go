@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"net"
+	"time"
 
 	"github.com/moby/buildkit/cache"
 	"github.com/moby/buildkit/solver/pb"
@@ -18,8 +19,14 @@ type Meta struct {
 	ReadonlyRootFS bool
 	ExtraHosts     []HostIP
 	NetMode        pb.NetMode
+	SecurityMode   pb.SecurityMode
+	ResourceLimits *pb.ResourceLimits
 }
 
+// KillGracePeriod is how long a process is given to exit after SIGTERM when
+// its context reaches the deadline, before it is killed with SIGKILL
+const KillGracePeriod = 10 * time.Second
+
 type Mount struct {
 	Src      cache.Mountable
 	Selector string
@@ -27,9 +34,25 @@ type Mount struct {
 	Readonly bool
 }
 
+// WinSize is the size of the terminal of a process
+type WinSize struct {
+	Rows uint32
+	Cols uint32
+}
+
+// ProcessInfo describes the process an executor runs and its standard
+// streams
+type ProcessInfo struct {
+	Meta           Meta
+	Stdin          io.ReadCloser
+	Stdout, Stderr io.WriteCloser
+	// Resize receives the new size of the terminal of processes that run
+	// with Meta.Tty
+	Resize <-chan WinSize
+}
+
 type Executor interface {
-	// TODO: add stdout/err
-	Exec(ctx context.Context, meta Meta, rootfs cache.Mountable, mounts []Mount, stdin io.ReadCloser, stdout, stderr io.WriteCloser) error
+	Exec(ctx context.Context, rootfs cache.Mountable, mounts []Mount, process ProcessInfo) error
 }
 
 type HostIP struct {
@@ -0,0 +1,105 @@
+package oci
+
+import (
+	"context"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/oci"
+	"github.com/moby/buildkit/solver/pb"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// MergeResourceLimits returns the limits with unset values taken from
+// defaults
+func MergeResourceLimits(limits, defaults *pb.ResourceLimits) *pb.ResourceLimits {
+	if defaults == nil {
+		return limits
+	}
+	if limits == nil {
+		return defaults
+	}
+	l := *limits
+	if l.CpuShares == 0 {
+		l.CpuShares = defaults.CpuShares
+	}
+	if l.CpuQuota == 0 && l.CpuPeriod == 0 {
+		l.CpuQuota = defaults.CpuQuota
+		l.CpuPeriod = defaults.CpuPeriod
+	}
+	if l.Memory == 0 {
+		l.Memory = defaults.Memory
+	}
+	if l.Pids == 0 {
+		l.Pids = defaults.Pids
+	}
+	return &l
+}
+
+// WithResourceLimits sets the cgroup limits of the process
+func WithResourceLimits(limits *pb.ResourceLimits) oci.SpecOpts {
+	return func(_ context.Context, _ oci.Client, _ *containers.Container, s *specs.Spec) error {
+		if limits == nil {
+			return nil
+		}
+		if s.Linux == nil {
+			s.Linux = &specs.Linux{}
+		}
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
+		}
+		r := s.Linux.Resources
+		if limits.CpuShares != 0 || limits.CpuQuota != 0 || limits.CpuPeriod != 0 {
+			if r.CPU == nil {
+				r.CPU = &specs.LinuxCPU{}
+			}
+			if limits.CpuShares != 0 {
+				shares := limits.CpuShares
+				r.CPU.Shares = &shares
+			}
+			if limits.CpuQuota != 0 {
+				quota := limits.CpuQuota
+				r.CPU.Quota = &quota
+			}
+			if limits.CpuPeriod != 0 {
+				period := limits.CpuPeriod
+				r.CPU.Period = &period
+			}
+		}
+		if limits.Memory != 0 {
+			if r.Memory == nil {
+				r.Memory = &specs.LinuxMemory{}
+			}
+			memory := limits.Memory
+			r.Memory.Limit = &memory
+			// don't allow swapping beyond the limit
+			r.Memory.Swap = &memory
+		}
+		if limits.Pids != 0 {
+			r.Pids = &specs.LinuxPids{Limit: limits.Pids}
+		}
+		return nil
+	}
+}
+
+// oomEventDelay is how long OOMError waits for an OOM kill to be reported
+// after the process exited, as the event may arrive after the exit status
+const oomEventDelay = 100 * time.Millisecond
+
+// OOMError returns an error if a process that exited with exitCode was killed
+// for exceeding its memory limit. oomKilled is closed when an OOM kill was
+// observed in the cgroup of the process. Exiting with SIGKILL is not enough
+// as the process may have been killed for other reasons.
+func OOMError(exitCode int, limits *pb.ResourceLimits, oomKilled <-chan struct{}) error {
+	if exitCode != 128+int(syscall.SIGKILL) || limits == nil || limits.Memory == 0 || oomKilled == nil {
+		return nil
+	}
+	select {
+	case <-oomKilled:
+	case <-time.After(oomEventDelay):
+		return nil
+	}
+	return errors.Errorf("process was killed (exit code: %d) for exceeding its memory limit of %d bytes", exitCode, limits.Memory)
+}
@@ -0,0 +1,38 @@
+package oci
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moby/buildkit/solver/pb"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceLimits(t *testing.T) {
+	t.Parallel()
+
+	defaults := &pb.ResourceLimits{CpuShares: 512, Memory: 1 << 30, Pids: 100}
+
+	require.Nil(t, MergeResourceLimits(nil, nil))
+	require.Equal(t, defaults, MergeResourceLimits(nil, defaults))
+
+	limits := MergeResourceLimits(&pb.ResourceLimits{Memory: 1 << 20, CpuQuota: 50000}, defaults)
+	require.Equal(t, &pb.ResourceLimits{CpuShares: 512, CpuQuota: 50000, Memory: 1 << 20, Pids: 100}, limits)
+
+	s := &specs.Spec{}
+	require.NoError(t, WithResourceLimits(limits)(context.TODO(), nil, nil, s))
+	require.Equal(t, uint64(512), *s.Linux.Resources.CPU.Shares)
+	require.Equal(t, int64(50000), *s.Linux.Resources.CPU.Quota)
+	require.Nil(t, s.Linux.Resources.CPU.Period)
+	require.Equal(t, int64(1<<20), *s.Linux.Resources.Memory.Limit)
+	require.Equal(t, int64(100), s.Linux.Resources.Pids.Limit)
+
+	killed := make(chan struct{})
+	require.NoError(t, OOMError(137, limits, killed))
+	require.NoError(t, OOMError(137, limits, nil))
+	close(killed)
+	require.Error(t, OOMError(137, limits, killed))
+	require.NoError(t, OOMError(1, limits, killed))
+	require.NoError(t, OOMError(137, &pb.ResourceLimits{Pids: 10}, killed))
+}
@@ -0,0 +1,93 @@
+// +build !windows
+
+package oci
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+)
+
+// WithInsecureSpec runs the process with all capabilities, without seccomp
+// and apparmor profiles and with access to all devices of the host.
+func WithInsecureSpec() oci.SpecOpts {
+	return func(ctx context.Context, c oci.Client, ctr *containers.Container, s *specs.Spec) error {
+		if err := oci.WithAllCapabilities(ctx, c, ctr, s); err != nil {
+			return err
+		}
+		if err := oci.WithSeccompUnconfined(ctx, c, ctr, s); err != nil {
+			return err
+		}
+		s.Process.ApparmorProfile = ""
+		s.Linux.ReadonlyPaths = []string{}
+		s.Linux.MaskedPaths = []string{}
+
+		devices, err := hostDevices("/dev")
+		if err != nil {
+			return err
+		}
+		s.Linux.Devices = append(s.Linux.Devices, devices...)
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
+		}
+		s.Linux.Resources.Devices = []specs.LinuxDeviceCgroup{{
+			Allow:  true,
+			Type:   "a",
+			Access: "rwm",
+		}}
+		return nil
+	}
+}
+
+// hostDevices returns the device nodes under dir
+func hostDevices(dir string) ([]specs.LinuxDevice, error) {
+	var out []specs.LinuxDevice
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) || os.IsPermission(err) {
+				return nil
+			}
+			return err
+		}
+		if fi.IsDir() {
+			switch filepath.Base(p) {
+			case "pts", "shm", "mqueue":
+				// these are set up for every container
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		var typ string
+		switch {
+		case fi.Mode()&os.ModeCharDevice != 0:
+			typ = "c"
+		case fi.Mode()&os.ModeDevice != 0:
+			typ = "b"
+		default:
+			return nil
+		}
+		st, ok := fi.Sys().(*syscall.Stat_t)
+		if !ok {
+			return nil
+		}
+		mode := fi.Mode() & os.ModePerm
+		uid, gid := uint32(st.Uid), uint32(st.Gid)
+		out = append(out, specs.LinuxDevice{
+			Path:     p,
+			Type:     typ,
+			Major:    int64(unix.Major(uint64(st.Rdev))),
+			Minor:    int64(unix.Minor(uint64(st.Rdev))),
+			FileMode: &mode,
+			UID:      &uid,
+			GID:      &gid,
+		})
+		return nil
+	})
+	return out, err
+}
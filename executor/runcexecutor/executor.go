@@ -3,6 +3,7 @@ package runcexecutor
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/containerd/console"
 	"github.com/containerd/containerd/contrib/seccomp"
 	"github.com/containerd/containerd/mount"
 	containerdoci "github.com/containerd/containerd/oci"
@@ -39,6 +41,8 @@ type Opt struct {
 	Rootless bool
 	// DefaultCgroupParent is the cgroup-parent name for executor
 	DefaultCgroupParent string
+	// DefaultResourceLimits are applied to processes that don't set their own
+	DefaultResourceLimits *pb.ResourceLimits
 }
 
 var defaultCommandCandidates = []string{"buildkit-runc", "runc"}
@@ -50,6 +54,7 @@ type runcExecutor struct {
 	cgroupParent     string
 	rootless         bool
 	networkProviders map[pb.NetMode]network.Provider
+	resourceLimits   *pb.ResourceLimits
 }
 
 func New(opt Opt, networkProviders map[pb.NetMode]network.Provider) (executor.Executor, error) {
@@ -101,11 +106,13 @@ func New(opt Opt, networkProviders map[pb.NetMode]network.Provider) (executor.Ex
 		cgroupParent:     opt.DefaultCgroupParent,
 		rootless:         opt.Rootless,
 		networkProviders: networkProviders,
+		resourceLimits:   opt.DefaultResourceLimits,
 	}
 	return w, nil
 }
 
-func (w *runcExecutor) Exec(ctx context.Context, meta executor.Meta, root cache.Mountable, mounts []executor.Mount, stdin io.ReadCloser, stdout, stderr io.WriteCloser) error {
+func (w *runcExecutor) Exec(ctx context.Context, root cache.Mountable, mounts []executor.Mount, process executor.ProcessInfo) error {
+	meta := process.Meta
 	provider, ok := w.networkProviders[meta.NetMode]
 	if !ok {
 		return errors.Errorf("unknown network mode %s", meta.NetMode)
@@ -172,7 +179,9 @@ func (w *runcExecutor) Exec(ctx context.Context, meta executor.Meta, root cache.
 	defer f.Close()
 
 	opts := []containerdoci.SpecOpts{oci.WithUIDGID(uid, gid, sgids)}
-	if system.SeccompSupported() {
+	if meta.SecurityMode == pb.SecurityMode_INSECURE {
+		opts = append(opts, oci.WithInsecureSpec())
+	} else if system.SeccompSupported() {
 		opts = append(opts, seccomp.WithDefaultProfile())
 	}
 	if meta.ReadonlyRootFS {
@@ -189,6 +198,8 @@ func (w *runcExecutor) Exec(ctx context.Context, meta executor.Meta, root cache.
 		}
 		opts = append(opts, containerdoci.WithCgroup(cgroupsPath))
 	}
+	limits := oci.MergeResourceLimits(meta.ResourceLimits, w.resourceLimits)
+	opts = append(opts, oci.WithResourceLimits(limits))
 	spec, cleanup, err := oci.GenerateSpec(ctx, meta, mounts, id, resolvConf, hostsFile, namespace, opts...)
 	if err != nil {
 		return err
@@ -217,35 +228,108 @@ func (w *runcExecutor) Exec(ctx context.Context, meta executor.Meta, root cache.
 		}
 	}
 
+	// OOM kills are counted in a parent of the cgroup of the container, as
+	// runc removes the cgroup before the exit code is returned
+	var oomCg *oomCgroup
+	if limits != nil && limits.Memory != 0 && !w.rootless {
+		if oomCg = nestCgroup(spec); oomCg != nil {
+			defer oomCg.remove()
+		}
+	}
+
 	if err := json.NewEncoder(f).Encode(spec); err != nil {
 		return err
 	}
 
+	runcIO := &forwardIO{stdin: process.Stdin, stdout: process.Stdout, stderr: process.Stderr}
+	var ptm console.Console
+	var outputDone chan struct{}
+	if meta.Tty {
+		var ptsName string
+		ptm, ptsName, err = console.NewPty()
+		if err != nil {
+			return errors.Wrap(err, "failed to create terminal")
+		}
+		defer ptm.Close()
+		pts, err := os.OpenFile(ptsName, os.O_RDWR|syscall.O_NOCTTY, 0)
+		if err != nil {
+			return errors.Wrap(err, "failed to open terminal")
+		}
+		if process.Stdin != nil {
+			go io.Copy(ptm, process.Stdin)
+		}
+		outputDone = make(chan struct{})
+		go func() {
+			if process.Stdout != nil {
+				io.Copy(process.Stdout, ptm)
+			}
+			close(outputDone)
+		}()
+		// runc connects the terminal of the container to its own stdio
+		runcIO = &forwardIO{stdin: pts, stdout: pts, stderr: pts}
+		defer func() {
+			pts.Close()
+			<-outputDone
+		}()
+	}
+
 	// runCtx/killCtx is used for extra check in case the kill command blocks
 	runCtx, cancelRun := context.WithCancel(context.Background())
 	defer cancelRun()
 
 	done := make(chan struct{})
 	go func() {
-		for {
+		select {
+		case <-ctx.Done():
+		case <-done:
+			return
+		}
+		if ctx.Err() == context.DeadlineExceeded {
+			// on timeout the process gets a chance to exit cleanly
+			killCtx, timeout := context.WithTimeout(context.Background(), 7*time.Second)
+			if err := w.runc.Kill(killCtx, id, int(syscall.SIGTERM), nil); err != nil {
+				logrus.Errorf("failed to terminate runc %s: %+v", id, err)
+			}
+			timeout()
 			select {
-			case <-ctx.Done():
-				killCtx, timeout := context.WithTimeout(context.Background(), 7*time.Second)
-				if err := w.runc.Kill(killCtx, id, int(syscall.SIGKILL), nil); err != nil {
-					logrus.Errorf("failed to kill runc %s: %+v", id, err)
-					select {
-					case <-killCtx.Done():
-						timeout()
-						cancelRun()
-						return
-					default:
-					}
-				}
-				timeout()
+			case <-time.After(executor.KillGracePeriod):
+			case <-done:
+				return
+			}
+		}
+		for {
+			killCtx, timeout := context.WithTimeout(context.Background(), 7*time.Second)
+			if err := w.runc.Kill(killCtx, id, int(syscall.SIGKILL), nil); err != nil {
+				logrus.Errorf("failed to kill runc %s: %+v", id, err)
 				select {
-				case <-time.After(50 * time.Millisecond):
-				case <-done:
+				case <-killCtx.Done():
+					timeout()
+					cancelRun()
 					return
+				default:
+				}
+			}
+			timeout()
+			select {
+			case <-time.After(50 * time.Millisecond):
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			select {
+			case ws := <-process.Resize:
+				// resizes are drained for processes without a terminal so
+				// that the sender does not block
+				if ptm == nil {
+					continue
+				}
+				ptm.Resize(console.WinSize{Height: uint16(ws.Rows), Width: uint16(ws.Cols)})
+				if err := w.signalResize(ctx, id); err != nil {
+					logrus.Debugf("failed to resize terminal of %s: %v", id, err)
 				}
 			case <-done:
 				return
@@ -253,9 +337,12 @@ func (w *runcExecutor) Exec(ctx context.Context, meta executor.Meta, root cache.
 		}
 	}()
 
+	oomKilled, stopOOM := w.watchOOM(ctx, id)
+	defer stopOOM()
+
 	logrus.Debugf("> creating %s %v", id, meta.Args)
 	status, err := w.runc.Run(runCtx, id, bundle, &runc.CreateOpts{
-		IO: &forwardIO{stdin: stdin, stdout: stdout, stderr: stderr},
+		IO: runcIO,
 	})
 	close(done)
 	if err != nil {
@@ -263,12 +350,86 @@ func (w *runcExecutor) Exec(ctx context.Context, meta executor.Meta, root cache.
 	}
 
 	if status != 0 {
+		// the events miss OOM kills from before runc could report them
+		if oomCg != nil {
+			if n, err := oomCg.oomKills(); err != nil {
+				logrus.Debugf("failed to read oom kills of %s: %v", id, err)
+			} else if n > 0 {
+				killed := make(chan struct{})
+				close(killed)
+				oomKilled = killed
+			}
+		}
+		if err := oci.OOMError(status, limits, oomKilled); err != nil {
+			return err
+		}
 		return errors.Errorf("exit code: %d", status)
 	}
 
 	return nil
 }
 
+// watchOOM follows the events of container id and returns a channel that is
+// closed when runc reports that a process of the container was killed for
+// running out of memory. runc can only report the events once the container
+// has been created, so the container is polled for until stop is called.
+func (w *runcExecutor) watchOOM(ctx context.Context, id string) (oomKilled <-chan struct{}, stop func()) {
+	killed := make(chan struct{})
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		for {
+			if _, err := w.runc.State(ctx, id); err == nil {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+		events, err := w.runc.Events(ctx, id, time.Minute)
+		if err != nil {
+			logrus.Debugf("failed to watch events of %s: %v", id, err)
+			return
+		}
+		for ev := range events {
+			switch ev.Type {
+			case "oom":
+				close(killed)
+				return
+			case "error":
+				logrus.Debugf("failed to read events of %s: %v", id, ev.Err)
+				return
+			}
+		}
+	}()
+	return killed, cancel
+}
+
+// signalResize notifies runc that the size of the terminal changed. runc
+// copies the size of its stdin to the terminal of the container on SIGWINCH.
+func (w *runcExecutor) signalResize(ctx context.Context, id string) error {
+	st, err := w.runc.State(ctx, id)
+	if err != nil {
+		return err
+	}
+	// runc is the parent of the init process of the container
+	dt, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", st.Pid))
+	if err != nil {
+		return err
+	}
+	s := string(dt)
+	fields := strings.Fields(s[strings.LastIndex(s, ")")+1:])
+	if len(fields) < 2 {
+		return errors.Errorf("invalid stat for pid %d", st.Pid)
+	}
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return err
+	}
+	return syscall.Kill(ppid, syscall.SIGWINCH)
+}
+
 type forwardIO struct {
 	stdin          io.ReadCloser
 	stdout, stderr io.WriteCloser
@@ -0,0 +1,77 @@
+package runcexecutor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// cgroupRoot is where the cgroup hierarchies are mounted
+const cgroupRoot = "/sys/fs/cgroup"
+
+// oomCgroup is the parent of the cgroup of a container. runc removes the
+// cgroup of a container when it exits, but the counters of the parent include
+// the events of its children and can still be read after that.
+type oomCgroup struct {
+	path string
+}
+
+// nestCgroup moves the cgroup of spec into a child of its cgroup path. It
+// returns nil if the cgroup is managed by systemd.
+func nestCgroup(spec *specs.Spec) *oomCgroup {
+	if spec.Linux == nil || spec.Linux.CgroupsPath == "" || strings.Contains(spec.Linux.CgroupsPath, ":") {
+		return nil
+	}
+	cg := &oomCgroup{path: spec.Linux.CgroupsPath}
+	spec.Linux.CgroupsPath = filepath.Join(cg.path, "container")
+	return cg
+}
+
+// oomKills returns the number of processes killed in the cgroup for running
+// out of memory. It is read from memory.events with cgroup v2 and from
+// memory.oom_control with cgroup v1.
+func (cg *oomCgroup) oomKills() (int, error) {
+	fn := filepath.Join(cgroupRoot, "memory", cg.path, "memory.oom_control")
+	if isCgroup2() {
+		fn = filepath.Join(cgroupRoot, cg.path, "memory.events")
+	}
+	dt, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return 0, err
+	}
+	return parseOOMKills(dt)
+}
+
+// remove removes the cgroup after runc removed the cgroup of the container
+// from it
+func (cg *oomCgroup) remove() {
+	if isCgroup2() {
+		os.Remove(filepath.Join(cgroupRoot, cg.path))
+		return
+	}
+	hierarchies, _ := ioutil.ReadDir(cgroupRoot)
+	for _, h := range hierarchies {
+		if h.IsDir() {
+			os.Remove(filepath.Join(cgroupRoot, h.Name(), cg.path))
+		}
+	}
+}
+
+func isCgroup2() bool {
+	_, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers"))
+	return err == nil
+}
+
+func parseOOMKills(dt []byte) (int, error) {
+	for _, l := range strings.Split(string(dt), "\n") {
+		if f := strings.Fields(l); len(f) == 2 && f[0] == "oom_kill" {
+			return strconv.Atoi(f[1])
+		}
+	}
+	return 0, errors.New("no oom_kill counter")
+}
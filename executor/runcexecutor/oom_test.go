@@ -0,0 +1,41 @@
+package runcexecutor
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOOMKills(t *testing.T) {
+	t.Parallel()
+
+	// cgroup v2 memory.events
+	n, err := parseOOMKills([]byte("low 0\nhigh 0\nmax 12\noom 2\noom_kill 1\noom_group_kill 0\n"))
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	// cgroup v1 memory.oom_control
+	n, err = parseOOMKills([]byte("oom_kill_disable 0\nunder_oom 0\noom_kill 3\n"))
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	// kernels before 4.13 don't count OOM kills in cgroup v1
+	_, err = parseOOMKills([]byte("oom_kill_disable 0\nunder_oom 0\n"))
+	require.Error(t, err)
+}
+
+func TestNestCgroup(t *testing.T) {
+	t.Parallel()
+
+	spec := &specs.Spec{Linux: &specs.Linux{CgroupsPath: "/buildkit/foo"}}
+	cg := nestCgroup(spec)
+	require.NotNil(t, cg)
+	require.Equal(t, "/buildkit/foo", cg.path)
+	require.Equal(t, "/buildkit/foo/container", spec.Linux.CgroupsPath)
+
+	// systemd cgroups are not nested
+	spec = &specs.Spec{Linux: &specs.Linux{CgroupsPath: "system.slice:buildkit:foo"}}
+	require.Nil(t, nestCgroup(spec))
+	require.Equal(t, "system.slice:buildkit:foo", spec.Linux.CgroupsPath)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
 	"github.com/moby/buildkit/exporter"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/util/push"
 	"github.com/moby/buildkit/util/resolver"
@@ -20,6 +21,12 @@ const (
 	keyPush      = "push"
 	keyInsecure  = "registry.insecure"
 	ociTypes     = "oci-mediatypes"
+	// keySourceDateEpoch clamps the timestamps of the image to a unix time
+	keySourceDateEpoch = "source-date-epoch"
+	// keyProvenance attaches the provenance of the build to the image
+	keyProvenance = "provenance"
+	// keySBOM attaches a software bill of materials to the image
+	keySBOM = "sbom"
 )
 
 type Opt struct {
@@ -68,6 +75,34 @@ func (e *imageExporter) Resolve(ctx context.Context, opt map[string]string) (exp
 				return nil, errors.Wrapf(err, "non-bool value specified for %s", k)
 			}
 			i.insecure = b
+		case keyProvenance:
+			if v == "" {
+				i.provenance = true
+				continue
+			}
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "non-bool value specified for %s", k)
+			}
+			i.provenance = b
+		case keySBOM:
+			if v == "" {
+				i.sbom = true
+				continue
+			}
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "non-bool value specified for %s", k)
+			}
+			i.sbom = b
+		case keySourceDateEpoch:
+			if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+				return nil, errors.Wrapf(err, "invalid value specified for %s", k)
+			}
+			if i.meta == nil {
+				i.meta = make(map[string][]byte)
+			}
+			i.meta[exptypes.ExporterEpochKey] = []byte(v)
 		case ociTypes:
 			if v == "" {
 				i.ociTypes = true
@@ -94,6 +129,8 @@ type imageExporterInstance struct {
 	push       bool
 	insecure   bool
 	ociTypes   bool
+	provenance bool
+	sbom       bool
 	meta       map[string][]byte
 }
 
@@ -108,6 +145,14 @@ func (e *imageExporterInstance) Export(ctx context.Context, src exporter.Source)
 	for k, v := range e.meta {
 		src.Metadata[k] = v
 	}
+	if !e.provenance {
+		delete(src.Metadata, exptypes.ExporterProvenanceKey)
+	}
+	if e.sbom {
+		if err := AddSBOMs(ctx, &src); err != nil {
+			return nil, err
+		}
+	}
 	desc, err := e.opt.ImageWriter.Commit(ctx, src, e.ociTypes)
 	if err != nil {
 		return nil, err
@@ -127,12 +172,21 @@ func (e *imageExporterInstance) Export(ctx context.Context, src exporter.Source)
 		targetNames := strings.Split(e.targetName, ",")
 		for _, targetName := range targetNames {
 			if e.opt.Images != nil {
+				// images in the image store need to have all their blobs
+				if err := FetchBlobs(ctx, src); err != nil {
+					return nil, err
+				}
 				tagDone := oneOffProgress(ctx, "naming to "+targetName)
 				img := images.Image{
 					Name:      targetName,
 					Target:    *desc,
 					CreatedAt: time.Now(),
 				}
+				if epoch, err := ParseEpoch(src.Metadata); err != nil {
+					return nil, tagDone(err)
+				} else if epoch != nil {
+					img.CreatedAt = *epoch
+				}
 
 				if _, err := e.opt.Images.Update(ctx, img); err != nil {
 					if !errdefs.IsNotFound(err) {
@@ -146,7 +200,11 @@ func (e *imageExporterInstance) Export(ctx context.Context, src exporter.Source)
 				tagDone(nil)
 			}
 			if e.push {
-				if err := push.Push(ctx, e.opt.SessionManager, e.opt.ImageWriter.ContentStore(), desc.Digest, targetName, e.insecure, e.opt.ResolverOpt); err != nil {
+				provider, mounts, err := lazyProvider(ctx, e.opt.ImageWriter.ContentStore(), src)
+				if err != nil {
+					return nil, err
+				}
+				if err := push.Push(ctx, e.opt.SessionManager, provider, desc.Digest, targetName, e.insecure, e.opt.ResolverOpt, mounts); err != nil {
 					return nil, err
 				}
 			}
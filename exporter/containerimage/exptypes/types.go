@@ -5,6 +5,22 @@ import specs "github.com/opencontainers/image-spec/specs-go/v1"
 const ExporterImageConfigKey = "containerimage.config"
 const ExporterPlatformsKey = "refs.platforms"
 
+// ExporterEpochKey is the unix time in seconds that all timestamps of the
+// exported image are clamped to
+const ExporterEpochKey = "source.date.epoch"
+
+// ExporterProvenanceKey is the provenance document of the build that is
+// attached to the exported image
+const ExporterProvenanceKey = "buildkit.provenance"
+
+// ExporterSBOMKey is the software bill of materials of the exported image.
+// Multi-platform images use a separate key for each platform, suffixed with
+// the platform ID.
+const ExporterSBOMKey = "buildkit.sbom"
+
+// ProvenanceMediaType is the media type of the provenance document
+const ProvenanceMediaType = "application/vnd.buildkit.provenance.v0+json"
+
 type Platforms struct {
 	Platforms []Platform
 }
@@ -0,0 +1,59 @@
+package containerimage
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/content"
+	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/exporter"
+	"github.com/moby/buildkit/util/contentutil"
+	digest "github.com/opencontainers/go-digest"
+)
+
+// FetchBlobs copies the layer blobs of lazily pulled refs in src to the
+// content store. Layers are not unpacked.
+func FetchBlobs(ctx context.Context, src exporter.Source) error {
+	for _, ref := range sourceRefs(src) {
+		if err := cache.FetchBlobs(ctx, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lazyProvider returns a provider for the blobs of src that reads the blobs
+// of lazily pulled refs from their registry. The returned map has the image
+// references the lazy blobs can be mounted from.
+func lazyProvider(ctx context.Context, cs content.Provider, src exporter.Source) (content.Provider, map[digest.Digest]string, error) {
+	provider := contentutil.NewMultiProvider(cs)
+	mounts := map[digest.Digest]string{}
+	for _, ref := range sourceRefs(src) {
+		lazy, err := cache.LazyBlobs(ctx, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, lb := range lazy {
+			provider.Add(lb.Descriptor.Digest, lb.Provider)
+			if lb.Ref != "" {
+				mounts[lb.Descriptor.Digest] = lb.Ref
+			}
+		}
+	}
+	return provider, mounts, nil
+}
+
+func sourceRefs(src exporter.Source) []cache.ImmutableRef {
+	if len(src.Refs) == 0 {
+		if src.Ref == nil {
+			return nil
+		}
+		return []cache.ImmutableRef{src.Ref}
+	}
+	refs := make([]cache.ImmutableRef, 0, len(src.Refs))
+	for _, ref := range src.Refs {
+		if ref != nil {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,57 @@
+package containerimage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moby/buildkit/exporter"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
+	"github.com/moby/buildkit/util/sbom"
+	"golang.org/x/sync/errgroup"
+)
+
+// AddSBOMs generates a software bill of materials for every image in src and
+// stores it in the source metadata for the image writer
+func AddSBOMs(ctx context.Context, src *exporter.Source) (err error) {
+	done := oneOffProgress(ctx, "generating sbom")
+	defer func() {
+		done(err)
+	}()
+
+	if src.Metadata == nil {
+		src.Metadata = make(map[string][]byte)
+	}
+	if len(src.Refs) == 0 {
+		dt, err := sbom.Generate(ctx, src.Ref)
+		if err != nil {
+			return err
+		}
+		src.Metadata[exptypes.ExporterSBOMKey] = dt
+		return nil
+	}
+
+	dts := make([][]byte, 0, len(src.Refs))
+	keys := make([]string, 0, len(src.Refs))
+	eg, ctx := errgroup.WithContext(ctx)
+	for k, ref := range src.Refs {
+		i := len(keys)
+		keys = append(keys, k)
+		dts = append(dts, nil)
+		ref := ref
+		eg.Go(func() error {
+			dt, err := sbom.Generate(ctx, ref)
+			if err != nil {
+				return err
+			}
+			dts[i] = dt
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	for i, k := range keys {
+		src.Metadata[fmt.Sprintf("%s/%s", exptypes.ExporterSBOMKey, k)] = dts[i]
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"runtime"
+	"strconv"
 	"time"
 
 	"github.com/containerd/containerd/content"
@@ -17,6 +18,7 @@ import (
 	"github.com/moby/buildkit/exporter/containerimage/exptypes"
 	"github.com/moby/buildkit/snapshot"
 	"github.com/moby/buildkit/util/progress"
+	"github.com/moby/buildkit/util/sbom"
 	"github.com/moby/buildkit/util/system"
 	digest "github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/image-spec/specs-go"
@@ -28,6 +30,13 @@ import (
 
 const (
 	emptyGZLayer = digest.Digest("sha256:4f4fb700ef54461cfa02571ae0db9a0dc1e0cdb5577484a6d75e68dc38e8acc1")
+
+	// annotationReferenceType and annotationReferenceDigest link an
+	// attestation manifest in the index to the image manifest it describes
+	annotationReferenceType   = "vnd.buildkit.reference.type"
+	annotationReferenceDigest = "vnd.buildkit.reference.digest"
+	referenceTypeProvenance   = "provenance"
+	referenceTypeSBOM         = "sbom"
 )
 
 type WriterOpt struct {
@@ -51,12 +60,28 @@ func (ic *ImageWriter) Commit(ctx context.Context, inp exporter.Source, oci bool
 		return nil, errors.Errorf("unable to export multiple refs, missing platforms mapping")
 	}
 
+	epoch, err := ParseEpoch(inp.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(inp.Refs) == 0 {
-		layers, err := ic.exportLayers(ctx, inp.Ref)
+		layers, err := ic.exportLayers(ctx, epoch, inp.Ref)
+		if err != nil {
+			return nil, err
+		}
+		config := inp.Metadata[exptypes.ExporterImageConfigKey]
+		desc, err := ic.commitDistributionManifest(ctx, inp.Ref, config, layers[0], oci, epoch)
+		atts := attestations(inp.Metadata, "")
+		if err != nil || len(atts) == 0 {
+			return desc, err
+		}
+		// an index is needed to attach attestations to a single image
+		desc.Platform, err = platformFromConfig(config)
 		if err != nil {
 			return nil, err
 		}
-		return ic.commitDistributionManifest(ctx, inp.Ref, inp.Metadata[exptypes.ExporterImageConfigKey], layers[0], oci)
+		return ic.commitIndex(ctx, []ocispec.Descriptor{*desc}, [][]attestation{atts}, oci)
 	}
 
 	var p exptypes.Platforms
@@ -75,11 +100,61 @@ func (ic *ImageWriter) Commit(ctx context.Context, inp exporter.Source, oci bool
 		refs = append(refs, r)
 	}
 
-	layers, err := ic.exportLayers(ctx, refs...)
+	layers, err := ic.exportLayers(ctx, epoch, refs...)
 	if err != nil {
 		return nil, err
 	}
 
+	manifests := make([]ocispec.Descriptor, 0, len(p.Platforms))
+	atts := make([][]attestation, 0, len(p.Platforms))
+	for _, p := range p.Platforms {
+		r, ok := inp.Refs[p.ID]
+		if !ok {
+			return nil, errors.Errorf("failed to find ref for ID %s", p.ID)
+		}
+		config := inp.Metadata[fmt.Sprintf("%s/%s", exptypes.ExporterImageConfigKey, p.ID)]
+
+		desc, err := ic.commitDistributionManifest(ctx, r, config, layers[layersMap[p.ID]], oci, epoch)
+		if err != nil {
+			return nil, err
+		}
+		dp := p.Platform
+		desc.Platform = &dp
+		manifests = append(manifests, *desc)
+		atts = append(atts, attestations(inp.Metadata, p.ID))
+	}
+
+	return ic.commitIndex(ctx, manifests, atts, oci)
+}
+
+// attestation is a document about an image that is exported in its own
+// manifest next to the image
+type attestation struct {
+	referenceType string
+	mediaType     string
+	dt            []byte
+}
+
+// attestations returns the attestations in the exporter metadata for the
+// image of the platform with id. id is empty for single platform images.
+func attestations(meta map[string][]byte, id string) []attestation {
+	var out []attestation
+	if dt := meta[exptypes.ExporterProvenanceKey]; len(dt) > 0 {
+		out = append(out, attestation{referenceType: referenceTypeProvenance, mediaType: exptypes.ProvenanceMediaType, dt: dt})
+	}
+	key := exptypes.ExporterSBOMKey
+	if id != "" {
+		key = fmt.Sprintf("%s/%s", exptypes.ExporterSBOMKey, id)
+	}
+	if dt := meta[key]; len(dt) > 0 {
+		out = append(out, attestation{referenceType: referenceTypeSBOM, mediaType: sbom.MediaType, dt: dt})
+	}
+	return out
+}
+
+// commitIndex writes an index for the image manifests. The attestations of
+// each image are added as separate manifests.
+func (ic *ImageWriter) commitIndex(ctx context.Context, manifests []ocispec.Descriptor, atts [][]attestation, oci bool) (*ocispec.Descriptor, error) {
 	idx := struct {
 		// MediaType is reserved in the OCI spec but
 		// excluded from go types.
@@ -99,23 +174,28 @@ func (ic *ImageWriter) Commit(ctx context.Context, inp exporter.Source, oci bool
 		idx.MediaType = images.MediaTypeDockerSchema2ManifestList
 	}
 
-	labels := map[string]string{}
+	idx.Manifests = append(idx.Manifests, manifests...)
 
-	for i, p := range p.Platforms {
-		r, ok := inp.Refs[p.ID]
-		if !ok {
-			return nil, errors.Errorf("failed to find ref for ID %s", p.ID)
-		}
-		config := inp.Metadata[fmt.Sprintf("%s/%s", exptypes.ExporterImageConfigKey, p.ID)]
-
-		desc, err := ic.commitDistributionManifest(ctx, r, config, layers[layersMap[p.ID]], oci)
-		if err != nil {
-			return nil, err
+	for i, desc := range manifests {
+		for _, att := range atts[i] {
+			adesc, err := ic.commitAttestationManifest(ctx, att, oci)
+			if err != nil {
+				return nil, err
+			}
+			adesc.Platform = &ocispec.Platform{
+				OS:           "unknown",
+				Architecture: "unknown",
+			}
+			adesc.Annotations = map[string]string{
+				annotationReferenceType:   att.referenceType,
+				annotationReferenceDigest: desc.Digest.String(),
+			}
+			idx.Manifests = append(idx.Manifests, *adesc)
 		}
-		dp := p.Platform
-		desc.Platform = &dp
-		idx.Manifests = append(idx.Manifests, *desc)
+	}
 
+	labels := map[string]string{}
+	for i, desc := range idx.Manifests {
 		labels[fmt.Sprintf("containerd.io/gc.ref.content.%d", i)] = desc.Digest.String()
 	}
 
@@ -137,7 +217,12 @@ func (ic *ImageWriter) Commit(ctx context.Context, inp exporter.Source, oci bool
 	}
 	idxDone(nil)
 
+	deleted := map[digest.Digest]struct{}{}
 	for _, desc := range idx.Manifests {
+		if _, ok := deleted[desc.Digest]; ok {
+			continue
+		}
+		deleted[desc.Digest] = struct{}{}
 		// delete manifest root. manifest will remain linked to the index
 		if err := ic.opt.ContentStore.Delete(context.TODO(), desc.Digest); err != nil {
 			return nil, errors.Wrap(err, "error removing manifest root")
@@ -147,7 +232,7 @@ func (ic *ImageWriter) Commit(ctx context.Context, inp exporter.Source, oci bool
 	return &idxDesc, nil
 }
 
-func (ic *ImageWriter) exportLayers(ctx context.Context, refs ...cache.ImmutableRef) ([][]blobs.DiffPair, error) {
+func (ic *ImageWriter) exportLayers(ctx context.Context, epoch *time.Time, refs ...cache.ImmutableRef) ([][]blobs.DiffPair, error) {
 	eg, ctx := errgroup.WithContext(ctx)
 	layersDone := oneOffProgress(ctx, "exporting layers")
 
@@ -160,6 +245,16 @@ func (ic *ImageWriter) exportLayers(ctx context.Context, refs ...cache.Immutable
 				if err != nil {
 					return errors.Wrap(err, "failed calculaing diff pairs for exported snapshot")
 				}
+				if epoch != nil {
+					// the layers are rewritten so lazily pulled blobs are needed
+					if err := cache.FetchBlobs(ctx, ref); err != nil {
+						return err
+					}
+					diffPairs, err = blobs.ClampTimestamps(ctx, ic.opt.ContentStore, diffPairs, *epoch)
+					if err != nil {
+						return err
+					}
+				}
 				out[i] = diffPairs
 				return nil
 			})
@@ -173,7 +268,7 @@ func (ic *ImageWriter) exportLayers(ctx context.Context, refs ...cache.Immutable
 	return out, nil
 }
 
-func (ic *ImageWriter) commitDistributionManifest(ctx context.Context, ref cache.ImmutableRef, config []byte, layers []blobs.DiffPair, oci bool) (*ocispec.Descriptor, error) {
+func (ic *ImageWriter) commitDistributionManifest(ctx context.Context, ref cache.ImmutableRef, config []byte, layers []blobs.DiffPair, oci bool, epoch *time.Time) (*ocispec.Descriptor, error) {
 	if len(config) == 0 {
 		var err error
 		config, err = emptyImageConfig()
@@ -189,7 +284,7 @@ func (ic *ImageWriter) commitDistributionManifest(ctx context.Context, ref cache
 
 	diffPairs, history := normalizeLayersAndHistory(layers, history, ref)
 
-	config, err = patchImageConfig(config, diffPairs, history)
+	config, err = patchImageConfig(config, diffPairs, history, epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -232,14 +327,28 @@ func (ic *ImageWriter) commitDistributionManifest(ctx context.Context, ref cache
 		"containerd.io/gc.ref.content.0": configDigest.String(),
 	}
 
+	// lazily pulled blobs are not in the content store
+	lazy, err := cache.LazyBlobs(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	sizes := map[digest.Digest]int64{}
+	for _, lb := range lazy {
+		sizes[lb.Descriptor.Digest] = lb.Descriptor.Size
+	}
+
 	for i, dp := range diffPairs {
-		info, err := ic.opt.ContentStore.Info(ctx, dp.Blobsum)
-		if err != nil {
-			return nil, errors.Wrapf(err, "could not find blob %s from contentstore", dp.Blobsum)
+		size, ok := sizes[dp.Blobsum]
+		if !ok {
+			info, err := ic.opt.ContentStore.Info(ctx, dp.Blobsum)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not find blob %s from contentstore", dp.Blobsum)
+			}
+			size = info.Size
 		}
 		mfst.Layers = append(mfst.Layers, ocispec.Descriptor{
 			Digest:    dp.Blobsum,
-			Size:      info.Size,
+			Size:      size,
 			MediaType: layerType,
 		})
 		labels[fmt.Sprintf("containerd.io/gc.ref.content.%d", i+1)] = dp.Blobsum.String()
@@ -286,6 +395,97 @@ func (ic *ImageWriter) commitDistributionManifest(ctx context.Context, ref cache
 	}, nil
 }
 
+// commitAttestationManifest writes a manifest with the attestation document
+// as its only layer
+func (ic *ImageWriter) commitAttestationManifest(ctx context.Context, att attestation, oci bool) (*ocispec.Descriptor, error) {
+	var (
+		manifestType = ocispec.MediaTypeImageManifest
+		configType   = ocispec.MediaTypeImageConfig
+	)
+	if !oci {
+		manifestType = images.MediaTypeDockerSchema2Manifest
+		configType = images.MediaTypeDockerSchema2Config
+	}
+
+	attDesc := ocispec.Descriptor{
+		Digest:    digest.FromBytes(att.dt),
+		Size:      int64(len(att.dt)),
+		MediaType: att.mediaType,
+	}
+
+	img := ocispec.Image{
+		Architecture: "unknown",
+		OS:           "unknown",
+	}
+	img.RootFS.Type = "layers"
+	img.RootFS.DiffIDs = []digest.Digest{attDesc.Digest}
+	config, err := json.Marshal(img)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal %s config", att.referenceType)
+	}
+	configDesc := ocispec.Descriptor{
+		Digest:    digest.FromBytes(config),
+		Size:      int64(len(config)),
+		MediaType: configType,
+	}
+
+	mfst := struct {
+		// MediaType is reserved in the OCI spec but
+		// excluded from go types.
+		MediaType string `json:"mediaType,omitempty"`
+
+		ocispec.Manifest
+	}{
+		MediaType: manifestType,
+		Manifest: ocispec.Manifest{
+			Versioned: specs.Versioned{
+				SchemaVersion: 2,
+			},
+			Config: configDesc,
+			Layers: []ocispec.Descriptor{attDesc},
+		},
+	}
+
+	mfstJSON, err := json.MarshalIndent(mfst, "", "   ")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal %s manifest", att.referenceType)
+	}
+	mfstDesc := ocispec.Descriptor{
+		Digest:    digest.FromBytes(mfstJSON),
+		Size:      int64(len(mfstJSON)),
+		MediaType: manifestType,
+	}
+
+	done := oneOffProgress(ctx, "exporting "+att.referenceType+" "+mfstDesc.Digest.String())
+
+	for _, b := range []struct {
+		desc ocispec.Descriptor
+		dt   []byte
+	}{{attDesc, att.dt}, {configDesc, config}} {
+		if err := content.WriteBlob(ctx, ic.opt.ContentStore, b.desc.Digest.String(), bytes.NewReader(b.dt), b.desc); err != nil {
+			return nil, done(errors.Wrapf(err, "error writing %s blob %s", att.referenceType, b.desc.Digest))
+		}
+	}
+
+	labels := map[string]string{
+		"containerd.io/gc.ref.content.0": configDesc.Digest.String(),
+		"containerd.io/gc.ref.content.1": attDesc.Digest.String(),
+	}
+	if err := content.WriteBlob(ctx, ic.opt.ContentStore, mfstDesc.Digest.String(), bytes.NewReader(mfstJSON), mfstDesc, content.WithLabels(labels)); err != nil {
+		return nil, done(errors.Wrapf(err, "error writing %s manifest blob %s", att.referenceType, mfstDesc.Digest))
+	}
+
+	// delete blob roots. blobs will remain linked to the manifest
+	for _, dgst := range []digest.Digest{attDesc.Digest, configDesc.Digest} {
+		if err := ic.opt.ContentStore.Delete(context.TODO(), dgst); err != nil {
+			return nil, done(errors.Wrapf(err, "error removing %s blob root", att.referenceType))
+		}
+	}
+	done(nil)
+
+	return &mfstDesc, nil
+}
+
 func (ic *ImageWriter) ContentStore() content.Store {
 	return ic.opt.ContentStore
 }
@@ -302,6 +502,27 @@ func emptyImageConfig() ([]byte, error) {
 	return dt, errors.Wrap(err, "failed to create empty image config")
 }
 
+// platformFromConfig returns the platform of an image config. An empty config
+// is for the current platform.
+func platformFromConfig(dt []byte) (*ocispec.Platform, error) {
+	if len(dt) == 0 {
+		return &ocispec.Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH}, nil
+	}
+	var config struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+		Variant      string `json:"variant,omitempty"`
+	}
+	if err := json.Unmarshal(dt, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse platform from config")
+	}
+	return &ocispec.Platform{
+		OS:           config.OS,
+		Architecture: config.Architecture,
+		Variant:      config.Variant,
+	}, nil
+}
+
 func parseHistoryFromConfig(dt []byte) ([]ocispec.History, error) {
 	var config struct {
 		History []ocispec.History
@@ -312,7 +533,7 @@ func parseHistoryFromConfig(dt []byte) ([]ocispec.History, error) {
 	return config.History, nil
 }
 
-func patchImageConfig(dt []byte, dps []blobs.DiffPair, history []ocispec.History) ([]byte, error) {
+func patchImageConfig(dt []byte, dps []blobs.DiffPair, history []ocispec.History, epoch *time.Time) ([]byte, error) {
 	m := map[string]json.RawMessage{}
 	if err := json.Unmarshal(dt, &m); err != nil {
 		return nil, errors.Wrap(err, "failed to parse image config for patch")
@@ -329,6 +550,15 @@ func patchImageConfig(dt []byte, dps []blobs.DiffPair, history []ocispec.History
 	}
 	m["rootfs"] = dt
 
+	if epoch != nil {
+		history = clampHistory(history, *epoch)
+		dt, err = json.Marshal(epoch.UTC())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal creation time")
+		}
+		m["created"] = dt
+	}
+
 	dt, err = json.Marshal(history)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to marshal history")
@@ -412,6 +642,35 @@ func normalizeLayersAndHistory(diffs []blobs.DiffPair, history []ocispec.History
 	return diffs, history
 }
 
+// clampHistory sets the creation time of history entries that are missing it
+// or were created after epoch to epoch
+func clampHistory(history []ocispec.History, epoch time.Time) []ocispec.History {
+	out := make([]ocispec.History, len(history))
+	for i, h := range history {
+		if h.Created == nil || h.Created.After(epoch) {
+			tm := epoch.UTC()
+			h.Created = &tm
+		}
+		out[i] = h
+	}
+	return out
+}
+
+// ParseEpoch returns the time set with ExporterEpochKey in the exporter
+// metadata or nil if it is not set
+func ParseEpoch(meta map[string][]byte) (*time.Time, error) {
+	v, ok := meta[exptypes.ExporterEpochKey]
+	if !ok || len(v) == 0 {
+		return nil, nil
+	}
+	sec, err := strconv.ParseInt(string(v), 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", exptypes.ExporterEpochKey)
+	}
+	tm := time.Unix(sec, 0).UTC()
+	return &tm, nil
+}
+
 type refMetadata struct {
 	description string
 	createdAt   time.Time
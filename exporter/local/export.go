@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +20,11 @@ import (
 	"golang.org/x/time/rate"
 )
 
+const (
+	// keySBOM adds a software bill of materials to the exported files
+	keySBOM = "sbom"
+)
+
 type Opt struct {
 	SessionManager *session.Manager
 }
@@ -48,12 +54,27 @@ func (e *localExporter) Resolve(ctx context.Context, opt map[string]string) (exp
 	}
 
 	li := &localExporterInstance{localExporter: e, caller: caller}
+	for k, v := range opt {
+		switch k {
+		case keySBOM:
+			if v == "" {
+				li.sbom = true
+				continue
+			}
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "non-bool value specified for %s", k)
+			}
+			li.sbom = b
+		}
+	}
 	return li, nil
 }
 
 type localExporterInstance struct {
 	*localExporter
 	caller session.Caller
+	sbom   bool
 }
 
 func (e *localExporterInstance) Name() string {
@@ -89,6 +110,13 @@ func (e *localExporterInstance) Export(ctx context.Context, inp exporter.Source)
 			}
 
 			fs := fsutil.NewFS(src, nil)
+			if e.sbom {
+				dt, err := generateSBOM(ctx, src, k)
+				if err != nil {
+					return err
+				}
+				fs = withFile(fs, sbomFilename, dt)
+			}
 			lbl := "copying files"
 			if isMap {
 				lbl += " " + k
@@ -145,3 +173,19 @@ func newProgressHandler(ctx context.Context, id string) func(int, bool) {
 		}
 	}
 }
+
+func oneOffProgress(ctx context.Context, id string) func(err error) error {
+	pw, _, _ := progress.FromContext(ctx)
+	now := time.Now()
+	st := progress.Status{
+		Started: &now,
+	}
+	pw.Write(id, st)
+	return func(err error) error {
+		now := time.Now()
+		st.Completed = &now
+		pw.Write(id, st)
+		pw.Close()
+		return err
+	}
+}
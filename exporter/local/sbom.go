@@ -0,0 +1,97 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/moby/buildkit/util/sbom"
+	"github.com/tonistiigi/fsutil"
+)
+
+// sbomFilename is the name of the software bill of materials file added to
+// the exported directory
+const sbomFilename = "sbom.cdx.json"
+
+// generateSBOM returns a CycloneDX document for the files at root
+func generateSBOM(ctx context.Context, root, k string) (dt []byte, err error) {
+	lbl := "generating sbom"
+	if k != "" {
+		lbl += " " + k
+	}
+	done := oneOffProgress(ctx, lbl)
+	defer func() {
+		done(err)
+	}()
+	pkgs, err := sbom.Scan(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	return sbom.CycloneDX(pkgs)
+}
+
+// withFile returns a filesystem that adds a regular file named name with the
+// contents dt to the root directory of fs. An existing entry with the same
+// name is replaced.
+func withFile(fs fsutil.FS, name string, dt []byte) fsutil.FS {
+	return &fileFS{FS: fs, name: name, dt: dt}
+}
+
+type fileFS struct {
+	fsutil.FS
+	name string
+	dt   []byte
+}
+
+func (fs *fileFS) Walk(ctx context.Context, fn filepath.WalkFunc) error {
+	stat := &fsutil.Stat{
+		Path:    fs.name,
+		Mode:    0644,
+		Size_:   int64(len(fs.dt)),
+		ModTime: time.Now().UnixNano(),
+	}
+	done := false
+	emit := func() error {
+		done = true
+		return fn(fs.name, &fsutil.StatInfo{Stat: stat}, nil)
+	}
+	err := fs.FS.Walk(ctx, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// the walk is in lexical order, the file is added before the first
+		// entry whose top level component sorts after it
+		top := strings.SplitN(filepath.ToSlash(p), "/", 2)[0]
+		if top == fs.name {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !done && top > fs.name {
+			if err := emit(); err != nil {
+				return err
+			}
+		}
+		return fn(p, fi, nil)
+	})
+	if err != nil {
+		return err
+	}
+	if !done {
+		return emit()
+	}
+	return nil
+}
+
+func (fs *fileFS) Open(p string) (io.ReadCloser, error) {
+	if p == fs.name {
+		return ioutil.NopCloser(bytes.NewReader(fs.dt)), nil
+	}
+	return fs.FS.Open(p)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/docker/distribution/reference"
 	"github.com/moby/buildkit/exporter"
 	"github.com/moby/buildkit/exporter/containerimage"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/filesync"
 	"github.com/moby/buildkit/util/dockerexporter"
@@ -25,6 +26,12 @@ const (
 	VariantOCI    = "oci"
 	VariantDocker = "docker"
 	ociTypes      = "oci-mediatypes"
+	// keySourceDateEpoch clamps the timestamps of the image to a unix time
+	keySourceDateEpoch = "source-date-epoch"
+	// keyProvenance attaches the provenance of the build to the image
+	keyProvenance = "provenance"
+	// keySBOM attaches a software bill of materials to the image
+	keySBOM = "sbom"
 )
 
 type Opt struct {
@@ -79,6 +86,34 @@ func (e *imageExporter) Resolve(ctx context.Context, opt map[string]string) (exp
 					return nil, err
 				}
 			}
+		case keyProvenance:
+			if v == "" {
+				i.provenance = true
+				continue
+			}
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "non-bool value specified for %s", k)
+			}
+			i.provenance = b
+		case keySBOM:
+			if v == "" {
+				i.sbom = true
+				continue
+			}
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "non-bool value specified for %s", k)
+			}
+			i.sbom = b
+		case keySourceDateEpoch:
+			if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+				return nil, errors.Wrapf(err, "invalid value specified for %s", k)
+			}
+			if i.meta == nil {
+				i.meta = make(map[string][]byte)
+			}
+			i.meta[exptypes.ExporterEpochKey] = []byte(v)
 		case ociTypes:
 			ot = new(bool)
 			if v == "" {
@@ -97,6 +132,12 @@ func (e *imageExporter) Resolve(ctx context.Context, opt map[string]string) (exp
 			i.meta[k] = []byte(v)
 		}
 	}
+	if i.provenance && e.opt.Variant == VariantDocker {
+		return nil, errors.Errorf("docker exporter does not currently support exporting provenance")
+	}
+	if i.sbom && e.opt.Variant == VariantDocker {
+		return nil, errors.Errorf("docker exporter does not currently support exporting sbom")
+	}
 	if ot == nil {
 		i.ociTypes = e.opt.Variant == VariantOCI
 	} else {
@@ -107,10 +148,12 @@ func (e *imageExporter) Resolve(ctx context.Context, opt map[string]string) (exp
 
 type imageExporterInstance struct {
 	*imageExporter
-	meta     map[string][]byte
-	caller   session.Caller
-	name     string
-	ociTypes bool
+	meta       map[string][]byte
+	caller     session.Caller
+	name       string
+	ociTypes   bool
+	provenance bool
+	sbom       bool
 }
 
 func (e *imageExporterInstance) Name() string {
@@ -128,6 +171,14 @@ func (e *imageExporterInstance) Export(ctx context.Context, src exporter.Source)
 	for k, v := range e.meta {
 		src.Metadata[k] = v
 	}
+	if !e.provenance {
+		delete(src.Metadata, exptypes.ExporterProvenanceKey)
+	}
+	if e.sbom {
+		if err := containerimage.AddSBOMs(ctx, &src); err != nil {
+			return nil, err
+		}
+	}
 
 	desc, err := e.opt.ImageWriter.Commit(ctx, src, e.ociTypes)
 	if err != nil {
@@ -139,7 +190,13 @@ func (e *imageExporterInstance) Export(ctx context.Context, src exporter.Source)
 	if desc.Annotations == nil {
 		desc.Annotations = map[string]string{}
 	}
-	desc.Annotations[ocispec.AnnotationCreated] = time.Now().UTC().Format(time.RFC3339)
+	created := time.Now()
+	if epoch, err := containerimage.ParseEpoch(src.Metadata); err != nil {
+		return nil, err
+	} else if epoch != nil {
+		created = *epoch
+	}
+	desc.Annotations[ocispec.AnnotationCreated] = created.UTC().Format(time.RFC3339)
 
 	resp := make(map[string]string)
 
@@ -158,6 +215,11 @@ func (e *imageExporterInstance) Export(ctx context.Context, src exporter.Source)
 		return nil, err
 	}
 
+	// the tarball contains all blobs of the image
+	if err := containerimage.FetchBlobs(ctx, src); err != nil {
+		return nil, err
+	}
+
 	w, err := filesync.CopyFileWriter(ctx, e.caller)
 	if err != nil {
 		return nil, err
@@ -11,12 +11,14 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/containerd/containerd/platforms"
 	"github.com/docker/docker/builder/dockerignore"
 	"github.com/moby/buildkit/client/llb"
 	"github.com/moby/buildkit/exporter/containerimage/exptypes"
 	"github.com/moby/buildkit/frontend/dockerfile/dockerfile2llb"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
 	"github.com/moby/buildkit/frontend/gateway/client"
 	"github.com/moby/buildkit/solver/pb"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
@@ -40,7 +42,16 @@ const (
 	keyImageResolveMode   = "image-resolve-mode"
 	keyGlobalAddHosts     = "add-hosts"
 	keyForceNetwork       = "force-network-mode"
-	keyOverrideCopyImage  = "override-copy-image" // remove after CopyOp implemented
+	keyRunTimeout         = "run-timeout"
+	keyRequestID          = "requestid"
+
+	// RequestOutline is the value of the requestid option that returns the
+	// targets of the Dockerfile in the result metadata instead of building
+	RequestOutline = "frontend.outline"
+
+	// buildArgSourceDateEpoch is the build arg that sets the unix time all
+	// timestamps of the exported image are clamped to
+	buildArgSourceDateEpoch = "SOURCE_DATE_EPOCH"
 )
 
 var httpPrefix = regexp.MustCompile("^https?://")
@@ -79,6 +90,21 @@ func Build(ctx context.Context, c client.Client) (*client.Result, error) {
 		return nil, err
 	}
 
+	var runTimeout time.Duration
+	if v := opts[keyRunTimeout]; v != "" {
+		runTimeout, err = time.ParseDuration(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", keyRunTimeout)
+		}
+	}
+
+	epoch := opts[buildArgPrefix+buildArgSourceDateEpoch]
+	if epoch != "" {
+		if _, err := strconv.ParseInt(epoch, 10, 64); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", buildArgSourceDateEpoch)
+		}
+	}
+
 	filename := opts[keyFilename]
 	if filename == "" {
 		filename = defaultDockerfileName
@@ -137,14 +163,9 @@ func Build(ctx context.Context, c client.Client) (*client.Result, error) {
 			return nil, errors.Errorf("failed to read downloaded context")
 		}
 		if isArchive(dt) {
-			copyImage := opts[keyOverrideCopyImage]
-			if copyImage == "" {
-				copyImage = dockerfile2llb.DefaultCopyImage
-			}
-			unpack := llb.Image(copyImage, dockerfile2llb.WithInternalName("helper image for file operations")).
-				Run(llb.Shlex("copy --unpack /src/context /out/"), llb.ReadonlyRootFS(), dockerfile2llb.WithInternalName("extracting build context"))
-			unpack.AddMount("/src", httpContext, llb.Readonly)
-			src = unpack.AddMount("/out", llb.Scratch())
+			src = llb.Scratch().File(llb.Copy(httpContext, "/context", "/", &llb.CopyInfo{
+				AttemptUnpack: true,
+			}), dockerfile2llb.WithInternalName("extracting build context"))
 			buildContext = &src
 		} else {
 			filename = "context"
@@ -229,10 +250,27 @@ func Build(ctx context.Context, c client.Client) (*client.Result, error) {
 	if _, ok := opts["cmdline"]; !ok {
 		ref, cmdline, ok := dockerfile2llb.DetectSyntax(bytes.NewBuffer(dtDockerfile))
 		if ok {
+			// the frontend of another syntax may not support the request and
+			// would build the Dockerfile instead
+			if id := opts[keyRequestID]; id != "" {
+				return nil, errors.Errorf("request %s is not supported for Dockerfiles with syntax %s", id, ref)
+			}
 			return forwardGateway(ctx, c, ref, cmdline)
 		}
 	}
 
+	switch id := opts[keyRequestID]; id {
+	case "":
+	case RequestOutline:
+		return outline(ctx, dtDockerfile, dockerfile2llb.ConvertOpt{
+			BuildArgs:      filter(opts, buildArgPrefix),
+			TargetPlatform: targetPlatforms[0],
+			BuildPlatforms: buildPlatforms,
+		})
+	default:
+		return nil, errors.Errorf("unsupported request %s", id)
+	}
+
 	exportMap := len(targetPlatforms) > 1
 
 	if v := opts[keyMultiPlatform]; v != "" {
@@ -253,25 +291,34 @@ func Build(ctx context.Context, c client.Client) (*client.Result, error) {
 
 	eg, ctx = errgroup.WithContext(ctx)
 
+	sourceMap := llb.NewSourceMap(filename, "Dockerfile", dtDockerfile)
+
 	for i, tp := range targetPlatforms {
 		func(i int, tp *specs.Platform) {
 			eg.Go(func() error {
+				// the warnings are the same for every platform
+				var warn func(string, [][]byte, *parser.Range)
+				if i == 0 {
+					warn = warnFunc(ctx, c, filename, dtDockerfile)
+				}
 				st, img, err := dockerfile2llb.Dockerfile2LLB(ctx, dtDockerfile, dockerfile2llb.ConvertOpt{
-					Target:            opts[keyTarget],
-					MetaResolver:      c,
-					BuildArgs:         filter(opts, buildArgPrefix),
-					Labels:            filter(opts, labelPrefix),
-					SessionID:         c.BuildOpts().SessionID,
-					BuildContext:      buildContext,
-					Excludes:          excludes,
-					IgnoreCache:       ignoreCache,
-					TargetPlatform:    tp,
-					BuildPlatforms:    buildPlatforms,
-					ImageResolveMode:  resolveMode,
-					PrefixPlatform:    exportMap,
-					ExtraHosts:        extraHosts,
-					ForceNetMode:      defaultNetMode,
-					OverrideCopyImage: opts[keyOverrideCopyImage],
+					Target:           opts[keyTarget],
+					MetaResolver:     c,
+					BuildArgs:        filter(opts, buildArgPrefix),
+					Labels:           filter(opts, labelPrefix),
+					SessionID:        c.BuildOpts().SessionID,
+					BuildContext:     buildContext,
+					Excludes:         excludes,
+					IgnoreCache:      ignoreCache,
+					TargetPlatform:   tp,
+					BuildPlatforms:   buildPlatforms,
+					ImageResolveMode: resolveMode,
+					PrefixPlatform:   exportMap,
+					ExtraHosts:       extraHosts,
+					ForceNetMode:     defaultNetMode,
+					RunTimeout:       runTimeout,
+					Warn:             warn,
+					SourceMap:        sourceMap,
 				})
 
 				if err != nil {
@@ -340,6 +387,25 @@ func Build(ctx context.Context, c client.Client) (*client.Result, error) {
 		res.AddMeta(exptypes.ExporterPlatformsKey, dt)
 	}
 
+	if epoch != "" {
+		res.AddMeta(exptypes.ExporterEpochKey, []byte(epoch))
+	}
+
+	return res, nil
+}
+
+// outline returns the targets of the Dockerfile as JSON in the result metadata
+func outline(ctx context.Context, dt []byte, opt dockerfile2llb.ConvertOpt) (*client.Result, error) {
+	o, err := dockerfile2llb.Dockerfile2Outline(ctx, dt, opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to outline Dockerfile")
+	}
+	dt, err = json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	res := client.NewResult()
+	res.AddMeta(RequestOutline, dt)
 	return res, nil
 }
 
@@ -477,3 +543,28 @@ func parseNetMode(v string) (pb.NetMode, error) {
 		return 0, errors.Errorf("invalid netmode %s", v)
 	}
 }
+
+// warnFunc returns a function that sends the warnings of the Dockerfile to
+// the client. Warnings are best effort and errors from older daemons that
+// don't support them are ignored.
+func warnFunc(ctx context.Context, c client.Client, filename string, dt []byte) func(string, [][]byte, *parser.Range) {
+	sourceInfo := &pb.SourceInfo{
+		Filename: filename,
+		Data:     dt,
+		Language: "Dockerfile",
+	}
+	return func(msg string, detail [][]byte, location *parser.Range) {
+		opts := client.WarnOpts{
+			Level:  client.WarnLevelWarning,
+			Detail: detail,
+		}
+		if location != nil {
+			opts.SourceInfo = sourceInfo
+			opts.Range = []*pb.Range{{
+				Start: pb.Position{Line: int32(location.Start.Line)},
+				End:   pb.Position{Line: int32(location.End.Line)},
+			}}
+		}
+		c.Warn(ctx, "", msg, opts)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/containerd/containerd/platforms"
 	"github.com/docker/distribution/reference"
@@ -33,8 +34,6 @@ const (
 	emptyImageName   = "scratch"
 	localNameContext = "context"
 	historyComment   = "buildkit.dockerfile.v0"
-
-	DefaultCopyImage = "tonistiigi/copy:v0.1.4@sha256:d9d49bedbbe2b27df88115e6aff7b9cd11ed2fbd8d9013f02d3da735c08c92e5"
 )
 
 type ConvertOpt struct {
@@ -49,92 +48,46 @@ type ConvertOpt struct {
 	// Empty slice means ignore cache for all stages. Nil doesn't disable cache.
 	IgnoreCache []string
 	// CacheIDNamespace scopes the IDs for different cache mounts
-	CacheIDNamespace  string
-	ImageResolveMode  llb.ResolveMode
-	TargetPlatform    *specs.Platform
-	BuildPlatforms    []specs.Platform
-	PrefixPlatform    bool
-	ExtraHosts        []llb.HostIP
-	ForceNetMode      pb.NetMode
-	OverrideCopyImage string
+	CacheIDNamespace string
+	ImageResolveMode llb.ResolveMode
+	TargetPlatform   *specs.Platform
+	BuildPlatforms   []specs.Platform
+	PrefixPlatform   bool
+	ExtraHosts       []llb.HostIP
+	ForceNetMode     pb.NetMode
+	// RunTimeout is the maximum duration of each RUN instruction
+	RunTimeout time.Duration
+	// Warn is called for deprecated instructions and unused build arguments.
+	// location is nil if the warning is not about a specific instruction.
+	Warn func(msg string, detail [][]byte, location *parser.Range)
+	// SourceMap is the Dockerfile that the locations of the ops refer to.
+	// Nil disables the locations.
+	SourceMap *llb.SourceMap
 }
 
 func Dockerfile2LLB(ctx context.Context, dt []byte, opt ConvertOpt) (*llb.State, *Image, error) {
-	if len(dt) == 0 {
-		return nil, nil, errors.Errorf("the Dockerfile cannot be empty")
-	}
-
-	platformOpt := buildPlatformOpt(&opt)
-
-	optMetaArgs := getPlatformArgs(platformOpt)
-	for i, arg := range optMetaArgs {
-		optMetaArgs[i] = setKVValue(arg, opt.BuildArgs)
-	}
-
-	dockerfile, err := parser.Parse(bytes.NewReader(dt))
-	if err != nil {
-		return nil, nil, err
-	}
-
 	proxyEnv := proxyEnvFromBuildArgs(opt.BuildArgs)
 
-	stages, metaArgs, err := instructions.Parse(dockerfile.AST)
+	df, err := parseDockerfile(dt, opt)
 	if err != nil {
 		return nil, nil, err
 	}
+	platformOpt := df.platformOpt
+	optMetaArgs := df.optMetaArgs
+	shlex := df.shlex
+	allDispatchStates := df.states
 
-	shlex := shell.NewLex(dockerfile.EscapeToken)
-
-	for _, metaArg := range metaArgs {
-		if metaArg.Value != nil {
-			*metaArg.Value, _ = shlex.ProcessWordWithMap(*metaArg.Value, metaArgsToMap(optMetaArgs))
-		}
-		optMetaArgs = append(optMetaArgs, setKVValue(metaArg.KeyValuePairOptional, opt.BuildArgs))
-	}
+	warnDockerfile(opt, df.stages, df.metaArgs, optMetaArgs)
 
 	metaResolver := opt.MetaResolver
 	if metaResolver == nil {
 		metaResolver = imagemetaresolver.Default()
 	}
 
-	allDispatchStates := newDispatchStates()
-
-	// set base state for every image
-	for i, st := range stages {
-		name, err := shlex.ProcessWordWithMap(st.BaseName, metaArgsToMap(optMetaArgs))
-		if err != nil {
-			return nil, nil, err
-		}
-		if name == "" {
-			return nil, nil, errors.Errorf("base name (%s) should not be blank", st.BaseName)
-		}
-		st.BaseName = name
-
-		ds := &dispatchState{
-			stage:          st,
-			deps:           make(map[*dispatchState]struct{}),
-			ctxPaths:       make(map[string]struct{}),
-			stageName:      st.Name,
-			prefixPlatform: opt.PrefixPlatform,
-		}
-
-		if st.Name == "" {
-			ds.stageName = fmt.Sprintf("stage-%d", i)
-		}
-
-		if v := st.Platform; v != "" {
-			v, err := shlex.ProcessWordWithMap(v, metaArgsToMap(optMetaArgs))
-			if err != nil {
-				return nil, nil, errors.Wrapf(err, "failed to process arguments for platform %s", v)
-			}
-
-			p, err := platforms.Parse(v)
-			if err != nil {
-				return nil, nil, errors.Wrapf(err, "failed to parse platform %s", v)
-			}
-			ds.platform = &p
+	for _, ds := range allDispatchStates.states {
+		if ds.unregistered {
+			continue
 		}
-		allDispatchStates.addState(ds)
 
 		total := 0
 		if ds.stage.BaseName != emptyImageName && ds.base == nil {
@@ -151,9 +104,9 @@ func Dockerfile2LLB(ctx context.Context, dt []byte, opt ConvertOpt) (*llb.State,
 		if opt.IgnoreCache != nil {
 			if len(opt.IgnoreCache) == 0 {
 				ds.ignoreCache = true
-			} else if st.Name != "" {
+			} else if ds.stage.Name != "" {
 				for _, n := range opt.IgnoreCache {
-					if strings.EqualFold(n, st.Name) {
+					if strings.EqualFold(n, ds.stage.Name) {
 						ds.ignoreCache = true
 					}
 				}
@@ -161,7 +114,7 @@ func Dockerfile2LLB(ctx context.Context, dt []byte, opt ConvertOpt) (*llb.State,
 		}
 	}
 
-	if len(allDispatchStates.states) == 1 {
+	if len(df.stages) == 1 {
 		allDispatchStates.states[0].stageName = ""
 	}
 
@@ -176,26 +129,6 @@ func Dockerfile2LLB(ctx context.Context, dt []byte, opt ConvertOpt) (*llb.State,
 		}
 	}
 
-	// fill dependencies to stages so unreachable ones can avoid loading image configs
-	for _, d := range allDispatchStates.states {
-		d.commands = make([]command, len(d.stage.Commands))
-		for i, cmd := range d.stage.Commands {
-			newCmd, err := toCommand(cmd, allDispatchStates)
-			if err != nil {
-				return nil, nil, err
-			}
-			d.commands[i] = newCmd
-			for _, src := range newCmd.sources {
-				if src != nil {
-					d.deps[src] = struct{}{}
-					if src.unregistered {
-						allDispatchStates.addState(src)
-					}
-				}
-			}
-		}
-	}
-
 	eg, ctx := errgroup.WithContext(ctx)
 	for i, d := range allDispatchStates.states {
 		reachable := isReachable(target, d)
@@ -264,7 +197,7 @@ func Dockerfile2LLB(ctx context.Context, dt []byte, opt ConvertOpt) (*llb.State,
 					if isScratch {
 						d.state = llb.Scratch()
 					} else {
-						d.state = llb.Image(d.stage.BaseName, dfCmd(d.stage.SourceCode), llb.Platform(*platform), opt.ImageResolveMode, llb.WithCustomName(prefixCommand(d, "FROM "+d.stage.BaseName, opt.PrefixPlatform, platform)))
+						d.state = llb.Image(d.stage.BaseName, dfCmd(d.stage.SourceCode), llb.Platform(*platform), opt.ImageResolveMode, llb.WithCustomName(prefixCommand(d, "FROM "+d.stage.BaseName, opt.PrefixPlatform, platform)), location(opt.SourceMap, d.stage.Location))
 					}
 					d.platform = platform
 					return nil
@@ -324,10 +257,8 @@ func Dockerfile2LLB(ctx context.Context, dt []byte, opt ConvertOpt) (*llb.State,
 			buildPlatforms:    platformOpt.buildPlatforms,
 			targetPlatform:    platformOpt.targetPlatform,
 			extraHosts:        opt.ExtraHosts,
-			copyImage:         opt.OverrideCopyImage,
-		}
-		if opt.copyImage == "" {
-			opt.copyImage = DefaultCopyImage
+			runTimeout:        opt.RunTimeout,
+			sourceMap:         opt.SourceMap,
 		}
 
 		if err = dispatchOnBuild(d, d.image.Config.OnBuild, opt); err != nil {
@@ -378,6 +309,138 @@ func Dockerfile2LLB(ctx context.Context, dt []byte, opt ConvertOpt) (*llb.State,
 	return &st, &target.image, nil
 }
 
+// parsedDockerfile is a Dockerfile with a dispatch state for every stage, and
+// for every image that is only copied or mounted from. The build arguments
+// are expanded in the FROM instructions.
+type parsedDockerfile struct {
+	platformOpt *platformOpt
+	shlex       *shell.Lex
+	stages      []instructions.Stage
+	// metaArgs are the ARG instructions before the first stage, with the
+	// values as written in the Dockerfile
+	metaArgs []instructions.ArgCommand
+	// optMetaArgs are the values of the platform and global build arguments
+	optMetaArgs []instructions.KeyValuePairOptional
+	states      *dispatchStates
+	// fromArgs are the build arguments used in the FROM instruction of every
+	// stage
+	fromArgs map[*dispatchState]map[string]struct{}
+}
+
+// parseDockerfile parses dt and sets up the dispatch states that are shared
+// by Dockerfile2LLB and Dockerfile2Outline
+func parseDockerfile(dt []byte, opt ConvertOpt) (*parsedDockerfile, error) {
+	if len(dt) == 0 {
+		return nil, errors.Errorf("the Dockerfile cannot be empty")
+	}
+
+	platformOpt := buildPlatformOpt(&opt)
+
+	optMetaArgs := getPlatformArgs(platformOpt)
+	for i, arg := range optMetaArgs {
+		optMetaArgs[i] = setKVValue(arg, opt.BuildArgs)
+	}
+
+	dockerfile, err := parser.Parse(bytes.NewReader(dt))
+	if err != nil {
+		return nil, err
+	}
+
+	stages, metaArgs, err := instructions.Parse(dockerfile.AST)
+	if err != nil {
+		return nil, err
+	}
+	if len(stages) == 0 {
+		return nil, errors.Errorf("the Dockerfile has no stages")
+	}
+
+	shlex := shell.NewLex(dockerfile.EscapeToken)
+
+	for _, metaArg := range metaArgs {
+		kv := metaArg.KeyValuePairOptional
+		if kv.Value != nil {
+			v, _ := shlex.ProcessWordWithMap(*kv.Value, metaArgsToMap(optMetaArgs))
+			kv.Value = &v
+		}
+		optMetaArgs = append(optMetaArgs, setKVValue(kv, opt.BuildArgs))
+	}
+
+	df := &parsedDockerfile{
+		platformOpt: platformOpt,
+		shlex:       shlex,
+		stages:      stages,
+		metaArgs:    metaArgs,
+		optMetaArgs: optMetaArgs,
+		states:      newDispatchStates(),
+		fromArgs:    map[*dispatchState]map[string]struct{}{},
+	}
+
+	// set base state for every image
+	for i, st := range stages {
+		name, used, err := shlex.ProcessWordWithMatches(st.BaseName, metaArgsToMap(optMetaArgs))
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			return nil, errors.Errorf("base name (%s) should not be blank", st.BaseName)
+		}
+		st.BaseName = name
+
+		ds := &dispatchState{
+			stage:          st,
+			deps:           make(map[*dispatchState]struct{}),
+			ctxPaths:       make(map[string]struct{}),
+			stageName:      st.Name,
+			prefixPlatform: opt.PrefixPlatform,
+		}
+
+		if st.Name == "" {
+			ds.stageName = fmt.Sprintf("stage-%d", i)
+		}
+
+		if v := st.Platform; v != "" {
+			v, matches, err := shlex.ProcessWordWithMatches(v, metaArgsToMap(optMetaArgs))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to process arguments for platform %s", v)
+			}
+
+			p, err := platforms.Parse(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse platform %s", v)
+			}
+			ds.stage.Platform = v
+			ds.platform = &p
+			for k := range matches {
+				used[k] = struct{}{}
+			}
+		}
+		df.fromArgs[ds] = used
+		df.states.addState(ds)
+	}
+
+	// fill dependencies to stages so unreachable ones can avoid loading image configs
+	for _, d := range df.states.states {
+		d.commands = make([]command, len(d.stage.Commands))
+		for i, cmd := range d.stage.Commands {
+			newCmd, err := toCommand(cmd, df.states)
+			if err != nil {
+				return nil, err
+			}
+			d.commands[i] = newCmd
+			for _, src := range newCmd.sources {
+				if src != nil {
+					d.deps[src] = struct{}{}
+					if src.unregistered {
+						df.states.addState(src)
+					}
+				}
+			}
+		}
+	}
+
+	return df, nil
+}
+
 func metaArgsToMap(metaArgs []instructions.KeyValuePairOptional) map[string]string {
 	m := map[string]string{}
 
@@ -432,7 +495,8 @@ type dispatchOpt struct {
 	targetPlatform    specs.Platform
 	buildPlatforms    []specs.Platform
 	extraHosts        []llb.HostIP
-	copyImage         string
+	runTimeout        time.Duration
+	sourceMap         *llb.SourceMap
 }
 
 func dispatch(d *dispatchState, cmd command, opt dispatchOpt) error {
@@ -456,7 +520,7 @@ func dispatch(d *dispatchState, cmd command, opt dispatchOpt) error {
 	case *instructions.WorkdirCommand:
 		err = dispatchWorkdir(d, c, true)
 	case *instructions.AddCommand:
-		err = dispatchCopy(d, c.SourcesAndDest, opt.buildContext, true, c, "", opt)
+		err = dispatchCopy(d, c.SourcesAndDest, opt.buildContext, true, c, "", c.Location(), opt)
 		if err == nil {
 			for _, src := range c.Sources() {
 				d.ctxPaths[path.Join("/", filepath.ToSlash(src))] = struct{}{}
@@ -489,7 +553,7 @@ func dispatch(d *dispatchState, cmd command, opt dispatchOpt) error {
 		if len(cmd.sources) != 0 {
 			l = cmd.sources[0].state
 		}
-		err = dispatchCopy(d, c.SourcesAndDest, l, false, c, c.Chown, opt)
+		err = dispatchCopy(d, c.SourcesAndDest, l, false, c, c.Chown, c.Location(), opt)
 		if err == nil && len(cmd.sources) == 0 {
 			for _, src := range c.Sources() {
 				d.ctxPaths[path.Join("/", filepath.ToSlash(src))] = struct{}{}
@@ -552,8 +616,15 @@ func (dss *dispatchStates) findStateByIndex(index int) (*dispatchState, error) {
 	return dss.states[index], nil
 }
 
+// lastTarget returns the last stage. The states of images that are only
+// copied or mounted from are added after the stages.
 func (dss *dispatchStates) lastTarget() *dispatchState {
-	return dss.states[len(dss.states)-1]
+	for i := len(dss.states) - 1; i > 0; i-- {
+		if !dss.states[i].unregistered {
+			return dss.states[i]
+		}
+	}
+	return dss.states[0]
 }
 
 type command struct {
@@ -562,6 +633,8 @@ type command struct {
 }
 
 func dispatchOnBuild(d *dispatchState, triggers []string, opt dispatchOpt) error {
+	// the lines of the triggers are not lines of the Dockerfile
+	opt.sourceMap = nil
 	for _, trigger := range triggers {
 		ast, err := parser.Parse(strings.NewReader(trigger))
 		if err != nil {
@@ -604,19 +677,30 @@ func dispatchRun(d *dispatchState, c *instructions.RunCommand, proxy *llb.ProxyE
 	for _, arg := range d.buildArgs {
 		opt = append(opt, llb.AddEnv(arg.Key, arg.ValueString()))
 	}
-	opt = append(opt, dfCmd(c))
+	opt = append(opt, dfCmd(c), location(dopt.sourceMap, c.Location()))
 	if d.ignoreCache {
 		opt = append(opt, llb.IgnoreCache)
 	}
 	if proxy != nil {
 		opt = append(opt, llb.WithProxy(*proxy))
 	}
+	if dopt.runTimeout > 0 {
+		opt = append(opt, llb.Timeout(dopt.runTimeout))
+	}
 
 	runMounts, err := dispatchRunMounts(d, c, sources, dopt)
 	if err != nil {
 		return err
 	}
 	opt = append(opt, runMounts...)
+
+	securityOpt, err := dispatchRunSecurity(c)
+	if err != nil {
+		return err
+	}
+	if securityOpt != nil {
+		opt = append(opt, securityOpt)
+	}
 	opt = append(opt, llb.WithCustomName(prefixCommand(d, uppercaseCmd(processCmdEnv(dopt.shlex, c.String(), d.state.Run(opt...).Env())), d.prefixPlatform, d.state.GetPlatform())))
 	for _, h := range dopt.extraHosts {
 		opt = append(opt, llb.AddExtraHost(h.Host, h.IP))
@@ -638,25 +722,16 @@ func dispatchWorkdir(d *dispatchState, c *instructions.WorkdirCommand, commit bo
 	return nil
 }
 
-func dispatchCopy(d *dispatchState, c instructions.SourcesAndDest, sourceState llb.State, isAddCommand bool, cmdToPrint fmt.Stringer, chown string, opt dispatchOpt) error {
-	// TODO: this should use CopyOp instead. Current implementation is inefficient
-	img := llb.Image(opt.copyImage, llb.MarkImageInternal, llb.Platform(opt.buildPlatforms[0]), WithInternalName("helper image for file operations"))
-
-	dest := path.Join(".", pathRelativeToWorkingDir(d.state, c.Dest()))
+func dispatchCopy(d *dispatchState, c instructions.SourcesAndDest, sourceState llb.State, isAddCommand bool, cmdToPrint fmt.Stringer, chown string, loc parser.Range, opt dispatchOpt) error {
+	dest := path.Join("/", pathRelativeToWorkingDir(d.state, c.Dest()))
 	if c.Dest() == "." || c.Dest()[len(c.Dest())-1] == filepath.Separator {
 		dest += string(filepath.Separator)
 	}
-	args := []string{"copy"}
-	unpack := isAddCommand
 
-	mounts := make([]llb.RunOption, 0, len(c.Sources()))
+	var copyOpt []llb.CopyOption
+
 	if chown != "" {
-		args = append(args, fmt.Sprintf("--chown=%s", chown))
-		_, _, err := parseUser(chown)
-		if err != nil {
-			mounts = append(mounts, llb.AddMount("/etc/passwd", d.state, llb.SourcePath("/etc/passwd"), llb.Readonly))
-			mounts = append(mounts, llb.AddMount("/etc/group", d.state, llb.SourcePath("/etc/group"), llb.Readonly))
-		}
+		copyOpt = append(copyOpt, llb.WithUser(chown))
 	}
 
 	commitMessage := bytes.NewBufferString("")
@@ -666,7 +741,9 @@ func dispatchCopy(d *dispatchState, c instructions.SourcesAndDest, sourceState l
 		commitMessage.WriteString("COPY")
 	}
 
-	for i, src := range c.Sources() {
+	var a *llb.FileAction
+
+	for _, src := range c.Sources() {
 		commitMessage.WriteString(" " + src)
 		if strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://") {
 			if !isAddCommand {
@@ -678,7 +755,6 @@ func dispatchCopy(d *dispatchState, c instructions.SourcesAndDest, sourceState l
 			//
 			// Note: mixing up remote archives and local archives in a single ADD instruction
 			// would result in undefined behavior: https://github.com/moby/buildkit/pull/387#discussion_r189494717
-			unpack = false
 			u, err := url.Parse(src)
 			f := "__unnamed__"
 			if err == nil {
@@ -686,41 +762,52 @@ func dispatchCopy(d *dispatchState, c instructions.SourcesAndDest, sourceState l
 					f = base
 				}
 			}
-			target := path.Join(fmt.Sprintf("/src-%d", i), f)
-			args = append(args, target)
-			mounts = append(mounts, llb.AddMount(path.Dir(target), llb.HTTP(src, llb.Filename(f), dfCmd(c)), llb.Readonly))
+
+			st := llb.HTTP(src, llb.Filename(f), dfCmd(c), location(opt.sourceMap, loc))
+
+			opts := append([]llb.CopyOption{&llb.CopyInfo{
+				CreateDestPath: true,
+			}}, copyOpt...)
+
+			if a == nil {
+				a = llb.Copy(st, f, dest, opts...)
+			} else {
+				a = a.Copy(st, f, dest, opts...)
+			}
 		} else {
-			d, f := splitWildcards(src)
-			targetCmd := fmt.Sprintf("/src-%d", i)
-			targetMount := targetCmd
-			if f == "" {
-				f = path.Base(src)
-				targetMount = path.Join(targetMount, f)
+			opts := append([]llb.CopyOption{&llb.CopyInfo{
+				FollowSymlinks:      true,
+				CopyDirContentsOnly: true,
+				AttemptUnpack:       isAddCommand,
+				CreateDestPath:      true,
+				AllowWildcard:       true,
+				AllowEmptyWildcard:  true,
+			}}, copyOpt...)
+
+			// sources are always relative to the root of the build context or stage
+			src = path.Join("/", src)
+
+			if a == nil {
+				a = llb.Copy(sourceState, src, dest, opts...)
+			} else {
+				a = a.Copy(sourceState, src, dest, opts...)
 			}
-			targetCmd = path.Join(targetCmd, f)
-			args = append(args, targetCmd)
-			mounts = append(mounts, llb.AddMount(targetMount, sourceState, llb.SourcePath(d), llb.Readonly))
 		}
 	}
 
 	commitMessage.WriteString(" " + c.Dest())
 
-	args = append(args, dest)
-	if unpack {
-		args = append(args[:1], append([]string{"--unpack"}, args[1:]...)...)
-	}
-
 	platform := opt.targetPlatform
 	if d.platform != nil {
 		platform = *d.platform
 	}
 
-	runOpt := []llb.RunOption{llb.Args(args), llb.Dir("/dest"), llb.ReadonlyRootFS(), dfCmd(cmdToPrint), llb.WithCustomName(prefixCommand(d, uppercaseCmd(processCmdEnv(opt.shlex, cmdToPrint.String(), d.state.Env())), d.prefixPlatform, &platform))}
+	fileOpt := []llb.ConstraintsOpt{dfCmd(cmdToPrint), llb.WithCustomName(prefixCommand(d, uppercaseCmd(processCmdEnv(opt.shlex, cmdToPrint.String(), d.state.Env())), d.prefixPlatform, &platform)), location(opt.sourceMap, loc)}
 	if d.ignoreCache {
-		runOpt = append(runOpt, llb.IgnoreCache)
+		fileOpt = append(fileOpt, llb.IgnoreCache)
 	}
-	run := img.Run(append(runOpt, mounts...)...)
-	d.state = run.AddMount("/dest", d.state).Platform(platform)
+
+	d.state = d.state.File(a, fileOpt...).Platform(platform)
 
 	return commitToHistory(&d.image, commitMessage.String(), true, &d.state)
 }
@@ -868,27 +955,6 @@ func pathRelativeToWorkingDir(s llb.State, p string) string {
 	return path.Join(s.GetDir(), p)
 }
 
-func splitWildcards(name string) (string, string) {
-	i := 0
-	for ; i < len(name); i++ {
-		ch := name[i]
-		if ch == '\\' {
-			i++
-		} else if ch == '*' || ch == '?' || ch == '[' {
-			break
-		}
-	}
-	if i == len(name) {
-		return name, ""
-	}
-
-	base := path.Base(name[:i])
-	if name[:i] == "" || strings.HasSuffix(name[:i], string(filepath.Separator)) {
-		base = ""
-	}
-	return path.Dir(name[:i]), base + name[i:]
-}
-
 func addEnv(env []string, k, v string) []string {
 	gotOne := false
 	for i, envVar := range env {
@@ -949,6 +1015,17 @@ func dfCmd(cmd interface{}) llb.ConstraintsOpt {
 	})
 }
 
+func location(sm *llb.SourceMap, loc parser.Range) llb.ConstraintsOpt {
+	if loc.Start.Line <= 0 {
+		// the command was not parsed from the Dockerfile
+		sm = nil
+	}
+	return sm.Location([]*pb.Range{{
+		Start: pb.Position{Line: int32(loc.Start.Line)},
+		End:   pb.Position{Line: int32(loc.End.Line)},
+	}})
+}
+
 func runCommandString(args []string, buildArgs []instructions.KeyValuePairOptional) string {
 	var tmpBuildEnv []string
 	for _, arg := range buildArgs {
@@ -989,42 +1066,6 @@ func isReachable(from, to *dispatchState) (ret bool) {
 	return false
 }
 
-func parseUser(str string) (uid uint32, gid uint32, err error) {
-	if str == "" {
-		return 0, 0, nil
-	}
-	parts := strings.SplitN(str, ":", 2)
-	for i, v := range parts {
-		switch i {
-		case 0:
-			uid, err = parseUID(v)
-			if err != nil {
-				return 0, 0, err
-			}
-			if len(parts) == 1 {
-				gid = uid
-			}
-		case 1:
-			gid, err = parseUID(v)
-			if err != nil {
-				return 0, 0, err
-			}
-		}
-	}
-	return
-}
-
-func parseUID(str string) (uint32, error) {
-	if str == "root" {
-		return 0, nil
-	}
-	uid, err := strconv.ParseUint(str, 10, 32)
-	if err != nil {
-		return 0, err
-	}
-	return uint32(uid), nil
-}
-
 func normalizeContextPaths(paths map[string]struct{}) []string {
 	pathSlice := make([]string, 0, len(paths))
 	for p := range paths {
@@ -1058,6 +1099,60 @@ func normalizeContextPaths(paths map[string]struct{}) []string {
 	return toSort
 }
 
+// warnDockerfile reports the MAINTAINER instructions and the build arguments
+// that are not declared in any stage
+func warnDockerfile(opt ConvertOpt, stages []instructions.Stage, metaArgs []instructions.ArgCommand, predefined []instructions.KeyValuePairOptional) {
+	if opt.Warn == nil {
+		return
+	}
+
+	declared := map[string]struct{}{}
+	for _, arg := range predefined {
+		declared[arg.Key] = struct{}{}
+	}
+	for _, arg := range metaArgs {
+		declared[arg.Key] = struct{}{}
+	}
+	for _, st := range stages {
+		for _, cmd := range st.Commands {
+			switch c := cmd.(type) {
+			case *instructions.ArgCommand:
+				declared[c.Key] = struct{}{}
+			case *instructions.MaintainerCommand:
+				location := c.Location()
+				opt.Warn("MAINTAINER instruction is deprecated", [][]byte{[]byte("Use a LABEL instead, for example: LABEL maintainer=\"" + c.Maintainer + "\"")}, &location)
+			}
+		}
+	}
+
+	var unused []string
+	for k := range opt.BuildArgs {
+		if _, ok := declared[k]; ok || isProxyArg(k) || isFrontendArg(k) {
+			continue
+		}
+		unused = append(unused, k)
+	}
+	sort.Strings(unused)
+	for _, k := range unused {
+		opt.Warn(fmt.Sprintf("build argument %s is not consumed by the Dockerfile", k), nil, nil)
+	}
+}
+
+func isProxyArg(k string) bool {
+	for _, p := range []string{"http_proxy", "https_proxy", "ftp_proxy", "no_proxy"} {
+		if strings.EqualFold(k, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFrontendArg returns true for the build arguments that are consumed by the
+// frontend instead of the Dockerfile
+func isFrontendArg(k string) bool {
+	return k == "SOURCE_DATE_EPOCH"
+}
+
 func proxyEnvFromBuildArgs(args map[string]string) *llb.ProxyEnv {
 	pe := &llb.ProxyEnv{}
 	isNil := true
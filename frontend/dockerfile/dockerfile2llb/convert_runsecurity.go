@@ -0,0 +1,18 @@
+// +build dfrunsecurity dfextall
+
+package dockerfile2llb
+
+import (
+	"github.com/moby/buildkit/client/llb"
+	"github.com/moby/buildkit/frontend/dockerfile/instructions"
+	"github.com/moby/buildkit/solver/pb"
+)
+
+func dispatchRunSecurity(c *instructions.RunCommand) (llb.RunOption, error) {
+	switch instructions.GetSecurity(c) {
+	case instructions.SecurityInsecure:
+		return llb.Security(pb.SecurityMode_INSECURE), nil
+	default:
+		return nil, nil
+	}
+}
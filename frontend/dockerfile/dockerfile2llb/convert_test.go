@@ -2,9 +2,14 @@ package dockerfile2llb
 
 import (
 	"testing"
+	"time"
 
+	"github.com/moby/buildkit/client/llb"
 	"github.com/moby/buildkit/frontend/dockerfile/instructions"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	"github.com/moby/buildkit/solver/pb"
 	"github.com/moby/buildkit/util/appcontext"
+	digest "github.com/opencontainers/go-digest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,6 +69,104 @@ COPY --from=0 f2 /
 	assert.Error(t, err)
 }
 
+func TestRunTimeout(t *testing.T) {
+	t.Parallel()
+	df := `FROM scratch
+RUN true
+`
+	st, _, err := Dockerfile2LLB(appcontext.Context(), []byte(df), ConvertOpt{
+		RunTimeout: time.Minute,
+	})
+	assert.NoError(t, err)
+
+	def, err := st.Marshal()
+	assert.NoError(t, err)
+
+	var found bool
+	for _, dt := range def.Def {
+		var op pb.Op
+		assert.NoError(t, (&op).Unmarshal(dt))
+		if _, ok := op.Op.(*pb.Op_Exec); ok {
+			found = true
+			assert.Equal(t, int64(60), def.Metadata[digest.FromBytes(dt)].Timeout)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestWarnings(t *testing.T) {
+	t.Parallel()
+	df := `FROM scratch
+ARG used
+MAINTAINER me
+`
+	type warning struct {
+		msg      string
+		location *parser.Range
+	}
+	var warnings []warning
+	_, _, err := Dockerfile2LLB(appcontext.Context(), []byte(df), ConvertOpt{
+		BuildArgs: map[string]string{
+			"used":       "1",
+			"unused":     "2",
+			"HTTP_PROXY": "3",
+			// consumed by the frontend
+			"SOURCE_DATE_EPOCH": "4",
+		},
+		Warn: func(msg string, detail [][]byte, location *parser.Range) {
+			warnings = append(warnings, warning{msg: msg, location: location})
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(warnings))
+	assert.Equal(t, "MAINTAINER instruction is deprecated", warnings[0].msg)
+	assert.Equal(t, &parser.Range{Start: parser.Position{Line: 3}, End: parser.Position{Line: 3}}, warnings[0].location)
+	assert.Equal(t, "build argument unused is not consumed by the Dockerfile", warnings[1].msg)
+	assert.Nil(t, warnings[1].location)
+}
+
+func TestSourceMap(t *testing.T) {
+	t.Parallel()
+	df := `FROM scratch
+ENV FOO bar
+RUN ls -l \
+  /foo
+`
+	sm := llb.NewSourceMap("Dockerfile", "Dockerfile", []byte(df))
+	st, _, err := Dockerfile2LLB(appcontext.Context(), []byte(df), ConvertOpt{
+		SourceMap: sm,
+	})
+	assert.NoError(t, err)
+
+	def, err := st.Marshal()
+	assert.NoError(t, err)
+	if !assert.NotNil(t, def.Source) {
+		return
+	}
+	assert.Equal(t, 1, len(def.Source.Infos))
+	assert.Equal(t, "Dockerfile", def.Source.Infos[0].Filename)
+
+	var found bool
+	for _, dt := range def.Def {
+		var op pb.Op
+		assert.NoError(t, (&op).Unmarshal(dt))
+		if op.GetExec() == nil {
+			continue
+		}
+		found = true
+		locs := def.Source.Locations[digest.FromBytes(dt)]
+		if !assert.NotNil(t, locs) {
+			return
+		}
+		assert.Equal(t, 1, len(locs.Locations))
+		assert.Equal(t, int32(0), locs.Locations[0].SourceIndex)
+		assert.Equal(t, int32(3), locs.Locations[0].Ranges[0].Start.Line)
+		assert.Equal(t, int32(4), locs.Locations[0].Ranges[0].End.Line)
+	}
+	assert.True(t, found)
+}
+
 func TestAddEnv(t *testing.T) {
 	// k exists in env as key
 	// override = true
@@ -0,0 +1,144 @@
+package dockerfile2llb
+
+import (
+	"context"
+
+	"github.com/moby/buildkit/frontend/dockerfile/instructions"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+)
+
+// Outline describes the targets of a Dockerfile and the build arguments they
+// use, without building them
+type Outline struct {
+	// Args are the build arguments declared before the first stage
+	Args    []OutlineArg    `json:"args,omitempty"`
+	Targets []OutlineTarget `json:"targets"`
+}
+
+// OutlineTarget is a stage of a Dockerfile that can be built as a target
+type OutlineTarget struct {
+	// Name is empty for stages without a name, that are built by their index
+	Name  string `json:"name,omitempty"`
+	Index int    `json:"index"`
+	// Default is set for the stage that is built when there is no target
+	Default bool `json:"default,omitempty"`
+	// Base is the image or the stage that the stage is based on
+	Base     string `json:"base"`
+	Platform string `json:"platform,omitempty"`
+	// Args are the build arguments used by the stage and the stages it
+	// depends on
+	Args []OutlineArg `json:"args,omitempty"`
+	// Images are the images that the stage and the stages it depends on are
+	// based on or copy files from
+	Images   []string     `json:"images,omitempty"`
+	Location parser.Range `json:"location"`
+}
+
+// OutlineArg is a build argument declared with ARG
+type OutlineArg struct {
+	Name string `json:"name"`
+	// Default is nil if the argument has no default value
+	Default  *string      `json:"default,omitempty"`
+	Location parser.Range `json:"location"`
+}
+
+// Dockerfile2Outline returns the targets of a Dockerfile. Build arguments of
+// opt are used for expanding the base images of the stages but don't change
+// the reported defaults.
+func Dockerfile2Outline(ctx context.Context, dt []byte, opt ConvertOpt) (*Outline, error) {
+	df, err := parseDockerfile(dt, opt)
+	if err != nil {
+		return nil, err
+	}
+	allDispatchStates := df.states
+
+	outline := &Outline{}
+	declared := map[string]OutlineArg{}
+	for _, metaArg := range df.metaArgs {
+		arg := OutlineArg{
+			Name:     metaArg.Key,
+			Default:  metaArg.Value,
+			Location: metaArg.Location(),
+		}
+		outline.Args = append(outline.Args, arg)
+		declared[arg.Name] = arg
+	}
+
+	defaultTarget := allDispatchStates.lastTarget()
+	for i, target := range allDispatchStates.states {
+		if target.unregistered {
+			continue
+		}
+		t := OutlineTarget{
+			Name:     target.stage.Name,
+			Index:    i,
+			Default:  target == defaultTarget,
+			Base:     target.stage.BaseName,
+			Platform: target.stage.Platform,
+			Location: target.stage.Location,
+		}
+
+		args := map[string]struct{}{}
+		addArg := func(arg OutlineArg) {
+			if _, ok := args[arg.Name]; ok {
+				return
+			}
+			args[arg.Name] = struct{}{}
+			t.Args = append(t.Args, arg)
+		}
+		images := map[string]struct{}{}
+		addImage := func(name string) {
+			if _, ok := images[name]; ok || name == emptyImageName {
+				return
+			}
+			images[name] = struct{}{}
+			t.Images = append(t.Images, name)
+		}
+
+		// stages that the target depends on are reported in the order of
+		// the Dockerfile
+		for _, d := range allDispatchStates.states {
+			if !isReachable(target, d) {
+				continue
+			}
+			// keep the order of the declarations
+			for _, arg := range outline.Args {
+				if _, ok := df.fromArgs[d][arg.Name]; ok {
+					addArg(arg)
+				}
+			}
+			for _, cmd := range d.stage.Commands {
+				c, ok := cmd.(*instructions.ArgCommand)
+				if !ok {
+					continue
+				}
+				arg := OutlineArg{
+					Name:     c.Key,
+					Default:  c.Value,
+					Location: c.Location(),
+				}
+				if arg.Default == nil {
+					// stage arguments without a value inherit the default
+					// of the global argument
+					arg.Default = declared[c.Key].Default
+				}
+				addArg(arg)
+			}
+			if d.base == nil {
+				addImage(d.stage.BaseName)
+			}
+			// images that are only copied or mounted from
+			for _, cmd := range d.commands {
+				for _, src := range cmd.sources {
+					if src != nil && src.unregistered {
+						addImage(src.stage.BaseName)
+					}
+				}
+			}
+		}
+
+		outline.Targets = append(outline.Targets, t)
+	}
+
+	return outline, nil
+}
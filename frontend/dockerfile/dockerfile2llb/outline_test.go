@@ -0,0 +1,67 @@
+package dockerfile2llb
+
+import (
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	"github.com/moby/buildkit/util/appcontext"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutline(t *testing.T) {
+	t.Parallel()
+	df := `ARG BASE=alpine
+ARG VERSION
+ARG UNUSED=foo
+FROM ${BASE} AS base
+ARG VERSION
+ARG FLAVOR=slim
+
+FROM busybox
+COPY --from=base /foo /
+COPY --from=golang:1.11 /usr/local/go /go
+ARG BUILDKIT
+`
+	outline, err := Dockerfile2Outline(appcontext.Context(), []byte(df), ConvertOpt{
+		BuildArgs: map[string]string{"BASE": "debian"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, len(outline.Args))
+	assert.Equal(t, "BASE", outline.Args[0].Name)
+	assert.Equal(t, "alpine", *outline.Args[0].Default)
+	assert.Equal(t, parser.Range{Start: parser.Position{Line: 1}, End: parser.Position{Line: 1}}, outline.Args[0].Location)
+	assert.Nil(t, outline.Args[1].Default)
+
+	if !assert.Equal(t, 2, len(outline.Targets)) {
+		return
+	}
+
+	base := outline.Targets[0]
+	assert.Equal(t, "base", base.Name)
+	assert.Equal(t, 0, base.Index)
+	assert.False(t, base.Default)
+	assert.Equal(t, "debian", base.Base)
+	assert.Equal(t, 4, base.Location.Start.Line)
+	assert.Equal(t, []string{"debian"}, base.Images)
+	assert.Equal(t, []string{"BASE", "VERSION", "FLAVOR"}, argNames(base.Args))
+	assert.Equal(t, "slim", *base.Args[2].Default)
+
+	last := outline.Targets[1]
+	assert.Equal(t, "", last.Name)
+	assert.Equal(t, 1, last.Index)
+	assert.True(t, last.Default)
+	assert.Equal(t, "busybox", last.Base)
+	assert.Equal(t, []string{"debian", "busybox", "golang:1.11"}, last.Images)
+	assert.Equal(t, []string{"BASE", "VERSION", "FLAVOR", "BUILDKIT"}, argNames(last.Args))
+	assert.Nil(t, last.Args[3].Default)
+	assert.Equal(t, 11, last.Args[3].Location.Start.Line)
+}
+
+func argNames(args []OutlineArg) []string {
+	names := make([]string, 0, len(args))
+	for _, arg := range args {
+		names = append(names, arg.Name)
+	}
+	return names
+}
@@ -50,9 +50,6 @@ func init() {
 
 	opts = []integration.TestOpt{
 		integration.WithMirroredImages(integration.OfficialImages("busybox:latest")),
-		integration.WithMirroredImages(map[string]string{
-			"tonistiigi/copy:v0.1.4": "docker.io/" + dockerfile2llb.DefaultCopyImage,
-		}),
 		integration.WithMatrix("frontend", frontends),
 	}
 
@@ -88,6 +85,7 @@ func TestIntegration(t *testing.T) {
 		testDockerignore,
 		testDockerignoreInvalid,
 		testDockerfileFromGit,
+		testDockerfileFromGitSubdir,
 		testCopyChown,
 		testCopyWildcards,
 		testCopyOverrideFiles,
@@ -112,6 +110,9 @@ func TestIntegration(t *testing.T) {
 		testExportMultiPlatform,
 		testQuotedMetaArgs,
 		testIgnoreEntrypoint,
+		testSourceDateEpoch,
+		testProvenanceFrontendAttrs,
+		testFrontendOutline,
 	}, opts...)
 }
 
@@ -1948,6 +1949,111 @@ COPY --from=build foo bar2
 	dt, err = ioutil.ReadFile(filepath.Join(destDir, "bar2"))
 	require.NoError(t, err)
 	require.Equal(t, "fromgit", string(dt))
+
+	// subdir of the repository is used as the context
+	err = os.MkdirAll(filepath.Join(gitDir, "sub"), 0700)
+	require.NoError(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(gitDir, "sub", "Dockerfile"), []byte(`
+FROM scratch
+COPY foo bar
+`), 0600)
+	require.NoError(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(gitDir, "sub", "foo"), []byte("fromsubdir"), 0600)
+	require.NoError(t, err)
+
+	err = runShell(gitDir,
+		"git add sub",
+		"git commit -m third",
+		"git update-server-info",
+	)
+	require.NoError(t, err)
+
+	destDir, err = ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(destDir)
+
+	_, err = f.Solve(context.TODO(), c, client.SolveOpt{
+		FrontendAttrs: map[string]string{
+			"context": server.URL + "/.git#master:sub",
+		},
+		Exporter:          client.ExporterLocal,
+		ExporterOutputDir: destDir,
+	}, nil)
+	require.NoError(t, err)
+
+	dt, err = ioutil.ReadFile(filepath.Join(destDir, "bar"))
+	require.NoError(t, err)
+	require.Equal(t, "fromsubdir", string(dt))
+}
+
+func testDockerfileFromGitSubdir(t *testing.T, sb integration.Sandbox) {
+	t.Parallel()
+	f := getFrontend(t, sb)
+
+	gitDir, err := ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(gitDir)
+
+	dockerfile := []byte(`
+FROM scratch
+COPY foo bar
+`)
+
+	err = os.MkdirAll(filepath.Join(gitDir, "docs"), 0700)
+	require.NoError(t, err)
+
+	for dir, foo := range map[string]string{"": "fromroot", "docs": "fromdocs"} {
+		err = ioutil.WriteFile(filepath.Join(gitDir, dir, "Dockerfile"), dockerfile, 0600)
+		require.NoError(t, err)
+		err = ioutil.WriteFile(filepath.Join(gitDir, dir, "foo"), []byte(foo), 0600)
+		require.NoError(t, err)
+	}
+
+	err = runShell(gitDir,
+		"git init",
+		"git config --local user.email test",
+		"git config --local user.name test",
+		"git add -A",
+		"git commit -m initial",
+		"git update-server-info",
+	)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.FileServer(http.Dir(filepath.Join(gitDir))))
+	defer server.Close()
+
+	c, err := client.New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	// the checkouts of the same commit with and without a subdir don't share
+	// the cache
+	for _, tc := range []struct {
+		ref string
+		exp string
+	}{
+		{"master", "fromroot"},
+		{"master:docs", "fromdocs"},
+	} {
+		destDir, err := ioutil.TempDir("", "buildkit")
+		require.NoError(t, err)
+		defer os.RemoveAll(destDir)
+
+		_, err = f.Solve(context.TODO(), c, client.SolveOpt{
+			FrontendAttrs: map[string]string{
+				"context": server.URL + "/.git#" + tc.ref,
+			},
+			Exporter:          client.ExporterLocal,
+			ExporterOutputDir: destDir,
+		}, nil)
+		require.NoError(t, err)
+
+		dt, err := ioutil.ReadFile(filepath.Join(destDir, "bar"))
+		require.NoError(t, err)
+		require.Equal(t, tc.exp, string(dt))
+	}
 }
 
 func testDockerfileFromHTTP(t *testing.T, sb integration.Sandbox) {
@@ -2362,6 +2468,252 @@ RUN echo bar > bar
 	require.Equal(t, img.Target, img2.Target)
 }
 
+func testSourceDateEpoch(t *testing.T, sb integration.Sandbox) {
+	t.Parallel()
+	f := getFrontend(t, sb)
+
+	dockerfile := []byte(`
+FROM busybox
+COPY foo /
+RUN echo bar > bar
+`)
+	dir, err := tmpdir(
+		fstest.CreateFile("Dockerfile", dockerfile, 0600),
+		fstest.CreateFile("foo", []byte("foo-contents"), 0600),
+	)
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c, err := client.New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	destDir, err := ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(destDir)
+
+	epoch := time.Unix(1500000000, 0).UTC()
+
+	build := func(name string) (ocispec.Descriptor, map[string]*testutil.TarItem) {
+		out := filepath.Join(destDir, name)
+		outW, err := os.Create(out)
+		require.NoError(t, err)
+
+		_, err = f.Solve(context.TODO(), c, client.SolveOpt{
+			FrontendAttrs: map[string]string{
+				"build-arg:SOURCE_DATE_EPOCH": fmt.Sprintf("%d", epoch.Unix()),
+				"no-cache":                    "",
+			},
+			LocalDirs: map[string]string{
+				builder.LocalNameDockerfile: dir,
+				builder.LocalNameContext:    dir,
+			},
+			Exporter:       client.ExporterOCI,
+			ExporterOutput: outW,
+		}, nil)
+		require.NoError(t, err)
+
+		dt, err := ioutil.ReadFile(out)
+		require.NoError(t, err)
+
+		m, err := testutil.ReadTarToMap(dt, false)
+		require.NoError(t, err)
+
+		var idx ocispec.Index
+		err = json.Unmarshal(m["index.json"].Data, &idx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(idx.Manifests))
+
+		require.Equal(t, epoch.Format(time.RFC3339), idx.Manifests[0].Annotations[ocispec.AnnotationCreated])
+		return idx.Manifests[0], m
+	}
+
+	desc, m := build("out1.tar")
+
+	var mfst ocispec.Manifest
+	err = json.Unmarshal(m["blobs/sha256/"+desc.Digest.Hex()].Data, &mfst)
+	require.NoError(t, err)
+
+	var img ocispec.Image
+	err = json.Unmarshal(m["blobs/sha256/"+mfst.Config.Digest.Hex()].Data, &img)
+	require.NoError(t, err)
+
+	require.Equal(t, epoch, img.Created.UTC())
+	for _, h := range img.History {
+		require.False(t, h.Created.After(epoch))
+	}
+
+	lastLayer := mfst.Layers[len(mfst.Layers)-1].Digest.Hex()
+	lm, err := testutil.ReadTarToMap(m["blobs/sha256/"+lastLayer].Data, true)
+	require.NoError(t, err)
+	require.Contains(t, lm, "bar")
+	require.Equal(t, epoch, lm["bar"].Header.ModTime.UTC())
+
+	desc2, _ := build("out2.tar")
+	require.Equal(t, desc.Digest, desc2.Digest)
+}
+
+func testProvenanceFrontendAttrs(t *testing.T, sb integration.Sandbox) {
+	t.Parallel()
+	f := getFrontend(t, sb)
+
+	dockerfile := []byte(`
+FROM scratch AS base
+ARG TOKEN
+COPY foo /
+`)
+	dir, err := tmpdir(
+		fstest.CreateFile("Dockerfile", dockerfile, 0600),
+		fstest.CreateFile("foo", []byte("foo-contents"), 0600),
+	)
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c, err := client.New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	destDir, err := ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(destDir)
+
+	epoch := time.Unix(1500000000, 0).UTC()
+
+	out := filepath.Join(destDir, "out.tar")
+	outW, err := os.Create(out)
+	require.NoError(t, err)
+
+	_, err = f.Solve(context.TODO(), c, client.SolveOpt{
+		FrontendAttrs: map[string]string{
+			"target":                      "base",
+			"build-arg:TOKEN":             "secret-token",
+			"build-arg:SOURCE_DATE_EPOCH": fmt.Sprintf("%d", epoch.Unix()),
+			"label:maintainer":            "secret-label",
+		},
+		LocalDirs: map[string]string{
+			builder.LocalNameDockerfile: dir,
+			builder.LocalNameContext:    dir,
+		},
+		Exporter: client.ExporterOCI,
+		ExporterAttrs: map[string]string{
+			"provenance": "true",
+		},
+		ExporterOutput: outW,
+	}, nil)
+	require.NoError(t, err)
+
+	dt, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+
+	m, err := testutil.ReadTarToMap(dt, false)
+	require.NoError(t, err)
+
+	var idx ocispec.Index
+	err = json.Unmarshal(m["index.json"].Data, &idx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(idx.Manifests))
+
+	err = json.Unmarshal(m["blobs/sha256/"+idx.Manifests[0].Digest.Hex()].Data, &idx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(idx.Manifests))
+
+	var mfst ocispec.Manifest
+	err = json.Unmarshal(m["blobs/sha256/"+idx.Manifests[1].Digest.Hex()].Data, &mfst)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(mfst.Layers))
+
+	dt = m["blobs/sha256/"+mfst.Layers[0].Digest.Hex()].Data
+	require.NotContains(t, string(dt), "secret-")
+
+	var prov struct {
+		FrontendAttrs map[string]string
+		BuildArgs     []string
+		StartedOn     time.Time
+		FinishedOn    time.Time
+	}
+	err = json.Unmarshal(dt, &prov)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{"target": "base"}, prov.FrontendAttrs)
+	require.Equal(t, []string{"SOURCE_DATE_EPOCH", "TOKEN"}, prov.BuildArgs)
+	require.Equal(t, epoch, prov.StartedOn.UTC())
+	require.Equal(t, epoch, prov.FinishedOn.UTC())
+}
+
+func testFrontendOutline(t *testing.T, sb integration.Sandbox) {
+	t.Parallel()
+	f := getFrontend(t, sb)
+
+	dockerfile := []byte(`
+ARG BASE=busybox
+FROM ${BASE} AS build
+ARG VERSION=1.0
+RUN echo $VERSION > /version
+
+FROM scratch
+COPY --from=build /version /
+`)
+
+	dir, err := tmpdir(
+		fstest.CreateFile("Dockerfile", dockerfile, 0600),
+	)
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c, err := client.New(context.TODO(), sb.Address())
+	require.NoError(t, err)
+	defer c.Close()
+
+	res, err := f.Solve(context.TODO(), c, client.SolveOpt{
+		FrontendAttrs: map[string]string{
+			"requestid": builder.RequestOutline,
+		},
+		LocalDirs: map[string]string{
+			builder.LocalNameDockerfile: dir,
+			builder.LocalNameContext:    dir,
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	dt, ok := res.ExporterResponse[builder.RequestOutline]
+	require.True(t, ok)
+
+	var outline dockerfile2llb.Outline
+	require.NoError(t, json.Unmarshal([]byte(dt), &outline))
+
+	require.Equal(t, 2, len(outline.Targets))
+	require.Equal(t, "build", outline.Targets[0].Name)
+	require.Equal(t, "busybox", outline.Targets[0].Base)
+	require.False(t, outline.Targets[0].Default)
+	require.True(t, outline.Targets[1].Default)
+
+	var args []string
+	for _, arg := range outline.Targets[1].Args {
+		args = append(args, arg.Name)
+	}
+	require.Equal(t, []string{"BASE", "VERSION"}, args)
+	require.Equal(t, []string{"busybox"}, outline.Targets[1].Images)
+
+	// Dockerfiles for other frontends are not outlined or built
+	dir2, err := tmpdir(
+		fstest.CreateFile("Dockerfile", append([]byte("# syntax=docker/dockerfile:experimental\n"), dockerfile...), 0600),
+	)
+	require.NoError(t, err)
+	defer os.RemoveAll(dir2)
+
+	_, err = f.Solve(context.TODO(), c, client.SolveOpt{
+		FrontendAttrs: map[string]string{
+			"requestid": builder.RequestOutline,
+		},
+		LocalDirs: map[string]string{
+			builder.LocalNameDockerfile: dir2,
+			builder.LocalNameContext:    dir2,
+		},
+	}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is not supported for Dockerfiles with syntax docker/dockerfile:experimental")
+}
+
 func testImportExportReproducibleIDs(t *testing.T, sb integration.Sandbox) {
 	var cdAddress string
 	if cd, ok := sb.(interface {
@@ -0,0 +1,66 @@
+// +build dfrunsecurity dfextall
+
+package instructions
+
+import (
+	"github.com/pkg/errors"
+)
+
+const SecurityInsecure = "insecure"
+const SecuritySandbox = "sandbox"
+
+var allowedSecurity = map[string]struct{}{
+	SecurityInsecure: {},
+	SecuritySandbox:  {},
+}
+
+type securityKeyT string
+
+var securityKey = securityKeyT("dockerfile/run/security")
+
+func init() {
+	parseRunPreHooks = append(parseRunPreHooks, runSecurityPreHook)
+	parseRunPostHooks = append(parseRunPostHooks, runSecurityPostHook)
+}
+
+func isValidSecurity(s string) bool {
+	_, ok := allowedSecurity[s]
+	return ok
+}
+
+func runSecurityPreHook(cmd *RunCommand, req parseRequest) error {
+	st := &securityState{}
+	st.flag = req.flags.AddString("security", SecuritySandbox)
+	cmd.setExternalValue(securityKey, st)
+	return nil
+}
+
+func runSecurityPostHook(cmd *RunCommand, req parseRequest) error {
+	st := getSecurityState(cmd)
+	if st == nil {
+		return errors.Errorf("no security state")
+	}
+	if !isValidSecurity(st.flag.Value) {
+		return errors.Errorf("security %q is not valid", st.flag.Value)
+	}
+	st.security = st.flag.Value
+	return nil
+}
+
+func getSecurityState(cmd *RunCommand) *securityState {
+	v := cmd.getExternalValue(securityKey)
+	if v == nil {
+		return nil
+	}
+	return v.(*securityState)
+}
+
+// GetSecurity returns the security mode set with RUN --security
+func GetSecurity(cmd *RunCommand) string {
+	return getSecurityState(cmd).security
+}
+
+type securityState struct {
+	flag     *Flag
+	security string
+}
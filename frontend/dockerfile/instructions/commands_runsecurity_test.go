@@ -0,0 +1,33 @@
+// +build dfrunsecurity dfextall
+
+package instructions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+func TestRunSecurity(t *testing.T) {
+	cases := map[string]string{
+		"RUN ls":                     SecuritySandbox,
+		"RUN --security=sandbox ls":  SecuritySandbox,
+		"RUN --security=insecure ls": SecurityInsecure,
+	}
+
+	for dt, expected := range cases {
+		ast, err := parser.Parse(strings.NewReader(dt))
+		assert.NilError(t, err)
+		cmd, err := ParseInstruction(ast.AST.Children[0])
+		assert.NilError(t, err)
+		assert.Check(t, is.Equal(expected, GetSecurity(cmd.(*RunCommand))))
+	}
+
+	ast, err := parser.Parse(strings.NewReader("RUN --security=foo ls"))
+	assert.NilError(t, err)
+	_, err = ParseInstruction(ast.AST.Children[0])
+	assert.Check(t, is.ErrorContains(err, `security "foo" is not valid`))
+}
@@ -38,6 +38,26 @@ type Node struct {
 	endLine    int             // the line in the original dockerfile where the node ends
 }
 
+// Position is a point in a Dockerfile. Lines start from 1.
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// Range is a range of lines in a Dockerfile
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// Location returns the lines of the Dockerfile the node was parsed from
+func (node *Node) Location() Range {
+	return Range{
+		Start: Position{Line: node.StartLine},
+		End:   Position{Line: node.endLine},
+	}
+}
+
 // Dump dumps the AST defined by `node` as a list of sexps.
 // Returns a string suitable for printing.
 func (node *Node) Dump() string {
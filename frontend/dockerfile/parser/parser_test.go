@@ -127,6 +127,8 @@ func TestParseIncludesLineNumbers(t *testing.T) {
 	for i, child := range ast.Children {
 		msg := fmt.Sprintf("Child %d", i)
 		assert.Check(t, is.DeepEqual(expected[i], []int{child.StartLine, child.endLine}), msg)
+		loc := child.Location()
+		assert.Check(t, is.DeepEqual(expected[i], []int{loc.Start.Line, loc.End.Line}), msg)
 	}
 }
 
@@ -56,19 +56,33 @@ func (s *Lex) ProcessWordsWithMap(word string, env map[string]string) ([]string,
 	return words, err
 }
 
+// ProcessWordWithMatches is like ProcessWordWithMap but also returns the keys
+// of 'env' that were referenced in 'word'.
+func (s *Lex) ProcessWordWithMatches(word string, env map[string]string) (string, map[string]struct{}, error) {
+	sw := s.newShellWord(word, env)
+	sw.matches = map[string]struct{}{}
+	word, _, err := sw.process(word)
+	return word, sw.matches, err
+}
+
 func (s *Lex) process(word string, env map[string]string) (string, []string, error) {
+	return s.newShellWord(word, env).process(word)
+}
+
+func (s *Lex) newShellWord(word string, env map[string]string) *shellWord {
 	sw := &shellWord{
 		envs:        env,
 		escapeToken: s.escapeToken,
 	}
 	sw.scanner.Init(strings.NewReader(word))
-	return sw.process(word)
+	return sw
 }
 
 type shellWord struct {
 	scanner     scanner.Scanner
 	envs        map[string]string
 	escapeToken rune
+	matches     map[string]struct{}
 }
 
 func (sw *shellWord) process(source string) (string, []string, error) {
@@ -367,6 +381,9 @@ func isSpecialParam(char rune) bool {
 func (sw *shellWord) getEnv(name string) string {
 	for key, value := range sw.envs {
 		if EqualEnvKeys(name, key) {
+			if sw.matches != nil {
+				sw.matches[key] = struct{}{}
+			}
 			return value
 		}
 	}
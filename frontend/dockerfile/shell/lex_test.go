@@ -174,3 +174,13 @@ func TestGetEnv(t *testing.T) {
 		t.Fatal("8 - 'car' should map to 'hat'")
 	}
 }
+
+func TestProcessWordWithMatches(t *testing.T) {
+	shlex := NewLex('\\')
+	env := map[string]string{"foo": "bar", "car": "hat", "unused": "x"}
+
+	word, matches, err := shlex.ProcessWordWithMatches("$foo/${car}:${missing:-def}", env)
+	assert.NilError(t, err)
+	assert.Check(t, is.Equal("bar/hat:def", word))
+	assert.Check(t, is.DeepEqual(map[string]struct{}{"foo": {}, "car": {}}, matches))
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/executor"
 	gw "github.com/moby/buildkit/frontend/gateway/client"
+	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/pb"
 	digest "github.com/opencontainers/go-digest"
 )
 
@@ -19,6 +21,37 @@ type FrontendLLBBridge interface {
 	Solve(ctx context.Context, req SolveRequest) (*Result, error)
 	ResolveImageConfig(ctx context.Context, ref string, opt gw.ResolveImageConfigOpt) (digest.Digest, []byte, error)
 	Exec(ctx context.Context, meta executor.Meta, rootfs cache.ImmutableRef, stdin io.ReadCloser, stdout, stderr io.WriteCloser) error
+	NewContainer(ctx context.Context, req NewContainerRequest) (Container, error)
+	// Warn shows a warning to the user and adds it to the response of the
+	// build. dgst is the vertex the warning is about and can be empty.
+	Warn(ctx context.Context, dgst digest.Digest, msg string, opts WarnOpts) error
+}
+
+type WarnOpts = gw.WarnOpts
+
+// NewContainerRequest describes a container with mounts of solved results
+// that a frontend can run processes in
+type NewContainerRequest struct {
+	Mounts  []Mount
+	NetMode pb.NetMode
+}
+
+// Mount is a mount of a container. Ref is nil for an empty mount.
+type Mount struct {
+	Dest      string
+	Selector  string
+	Readonly  bool
+	MountType pb.MountType
+	Ref       solver.CachedResult
+}
+
+// Container runs processes in its mounts until it is released. Changes to
+// the writable mounts are seen by all processes of the container.
+type Container interface {
+	// Exec runs a process in the container. The Meta of the process does not
+	// need to set NetMode. Only one process can run at a time.
+	Exec(ctx context.Context, process executor.ProcessInfo) error
+	Release(ctx context.Context) error
 }
 
 type SolveRequest = gw.SolveRequest
@@ -2,22 +2,99 @@ package client
 
 import (
 	"context"
+	"io"
 
 	"github.com/moby/buildkit/solver/pb"
 	digest "github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/tonistiigi/fsutil"
 )
 
 type Client interface {
 	Solve(ctx context.Context, req SolveRequest) (*Result, error)
 	ResolveImageConfig(ctx context.Context, ref string, opt ResolveImageConfigOpt) (digest.Digest, []byte, error)
 	BuildOpts() BuildOpts
+	NewContainer(ctx context.Context, req NewContainerRequest) (Container, error)
+	// Warn shows a warning to the user and adds it to the response of the
+	// build. dgst is the vertex the warning is about and can be empty.
+	Warn(ctx context.Context, dgst digest.Digest, msg string, opts WarnOpts) error
+}
+
+// Levels of warnings
+const (
+	WarnLevelInfo    = 1
+	WarnLevelWarning = 2
+	WarnLevelError   = 3
+)
+
+// WarnOpts are the optional details of a warning
+type WarnOpts struct {
+	// Level defaults to WarnLevelWarning
+	Level  int
+	Detail [][]byte
+	// SourceInfo and Range are the location in a source file the warning
+	// is about
+	SourceInfo *pb.SourceInfo
+	Range      []*pb.Range
+}
+
+// NewContainerRequest describes a container with mounts of solved references
+// that processes can be started in
+type NewContainerRequest struct {
+	Mounts  []Mount
+	NetMode pb.NetMode
+}
+
+// Mount is a mount of a container. Ref is nil for an empty mount. Only bind
+// and tmpfs mounts are supported.
+type Mount struct {
+	Dest      string
+	Selector  string
+	Readonly  bool
+	MountType pb.MountType
+	Ref       Reference
+}
+
+// Container runs processes in its mounts until it is released. Changes to
+// the writable mounts are seen by all processes of the container. Only one
+// process can run at a time, a process is started after the previous one has
+// exited.
+type Container interface {
+	Start(ctx context.Context, req StartRequest) (ContainerProcess, error)
+	// Release stops the running processes and releases the mounts
+	Release(ctx context.Context) error
+}
+
+// StartRequest describes a process started in a container
+type StartRequest struct {
+	Args   []string
+	Env    []string
+	User   string
+	Cwd    string
+	Tty    bool
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+	Stderr io.WriteCloser
+}
+
+// ContainerProcess is a process running in a container
+type ContainerProcess interface {
+	// Wait returns when the process exits, with an error if it failed
+	Wait() error
+	// Resize changes the size of the terminal of a process started with Tty
+	Resize(ctx context.Context, size WinSize) error
+}
+
+// WinSize is the size of a terminal
+type WinSize struct {
+	Rows uint32
+	Cols uint32
 }
 
 type Reference interface {
 	ReadFile(ctx context.Context, req ReadRequest) ([]byte, error)
-	// StatFile(ctx context.Context, req StatRequest) (*StatResponse, error)
-	// ReadDir(ctx context.Context, req ReadDirRequest) ([]*StatResponse, error)
+	StatFile(ctx context.Context, req StatRequest) (*fsutil.Stat, error)
+	ReadDir(ctx context.Context, req ReadDirRequest) ([]*fsutil.Stat, error)
 }
 
 type ReadRequest struct {
@@ -30,6 +107,16 @@ type FileRange struct {
 	Length int
 }
 
+type StatRequest struct {
+	Path string
+}
+
+type ReadDirRequest struct {
+	Path string
+	// IncludePattern is a glob matched against the names of the entries
+	IncludePattern string
+}
+
 // SolveRequest is same as frontend.SolveRequest but avoiding dependency
 type SolveRequest struct {
 	Definition      *pb.Definition
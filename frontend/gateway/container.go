@@ -0,0 +1,207 @@
+package gateway
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/gogo/googleapis/google/rpc"
+	"github.com/moby/buildkit/executor"
+	"github.com/moby/buildkit/frontend"
+	pb "github.com/moby/buildkit/frontend/gateway/pb"
+	"github.com/moby/buildkit/util/iostream"
+	"github.com/moby/buildkit/util/tracing"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/status"
+)
+
+func (lbf *llbBridgeForwarder) NewContainer(ctx context.Context, in *pb.NewContainerRequest) (*pb.NewContainerResponse, error) {
+	ctx = tracing.ContextWithSpanFromContext(ctx, lbf.callCtx)
+	if in.ContainerID == "" {
+		return nil, errors.Errorf("container ID is required")
+	}
+
+	req := frontend.NewContainerRequest{
+		NetMode: in.Network,
+	}
+	for _, m := range in.Mounts {
+		ref, err := lbf.convertRef(m.Ref)
+		if err != nil {
+			return nil, err
+		}
+		req.Mounts = append(req.Mounts, frontend.Mount{
+			Dest:      m.Dest,
+			Selector:  m.Selector,
+			Readonly:  m.Readonly,
+			MountType: m.MountType,
+			Ref:       ref,
+		})
+	}
+
+	lbf.mu.Lock()
+	_, exists := lbf.ctrs[in.ContainerID]
+	lbf.mu.Unlock()
+	if exists {
+		return nil, errors.Errorf("container %s already exists", in.ContainerID)
+	}
+
+	ctr, err := lbf.llbBridge.NewContainer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	lbf.mu.Lock()
+	defer lbf.mu.Unlock()
+	if _, exists := lbf.ctrs[in.ContainerID]; exists {
+		go ctr.Release(context.TODO())
+		return nil, errors.Errorf("container %s already exists", in.ContainerID)
+	}
+	lbf.ctrs[in.ContainerID] = ctr
+	return &pb.NewContainerResponse{}, nil
+}
+
+func (lbf *llbBridgeForwarder) ReleaseContainer(ctx context.Context, in *pb.ReleaseContainerRequest) (*pb.ReleaseContainerResponse, error) {
+	lbf.mu.Lock()
+	ctr, ok := lbf.ctrs[in.ContainerID]
+	delete(lbf.ctrs, in.ContainerID)
+	lbf.mu.Unlock()
+	if !ok {
+		return nil, errors.Errorf("no such container: %s", in.ContainerID)
+	}
+	if err := ctr.Release(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.ReleaseContainerResponse{}, nil
+}
+
+func (lbf *llbBridgeForwarder) ExecProcess(srv pb.LLBBridge_ExecProcessServer) error {
+	ctx := srv.Context()
+
+	msg, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	init := msg.GetInit()
+	if init == nil {
+		return errors.Errorf("first exec message needs to be init")
+	}
+	if init.Meta == nil || len(init.Meta.Args) == 0 {
+		return errors.Errorf("no command for process")
+	}
+
+	lbf.mu.Lock()
+	ctr, ok := lbf.ctrs[init.ContainerID]
+	lbf.mu.Unlock()
+	if !ok {
+		return errors.Errorf("no such container: %s", init.ContainerID)
+	}
+
+	var mu sync.Mutex
+	send := func(msg *pb.ExecMessage) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return srv.Send(msg)
+	}
+
+	process := executor.ProcessInfo{
+		Meta: executor.Meta{
+			Args: init.Meta.Args,
+			Env:  init.Meta.Env,
+			Cwd:  init.Meta.Cwd,
+			User: init.Meta.User,
+			Tty:  init.Tty,
+		},
+	}
+	if process.Meta.Cwd == "" {
+		process.Meta.Cwd = "/"
+	}
+
+	var stdinW *os.File
+	for _, fd := range init.Fds {
+		switch fd {
+		case 0:
+			r, w, err := iostream.StdinPipe()
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+			process.Stdin = r
+			stdinW = w
+		case 1, 2:
+			fd := fd
+			w := iostream.NewWriter(func(dt []byte) error {
+				return send(&pb.ExecMessage{Input: &pb.ExecMessage_File{File: &pb.FdMessage{Fd: fd, Data: dt}}})
+			})
+			if fd == 1 {
+				process.Stdout = w
+			} else {
+				process.Stderr = w
+			}
+		default:
+			return errors.Errorf("unsupported file descriptor %d", fd)
+		}
+	}
+
+	resize := make(chan executor.WinSize, 1)
+	process.Resize = resize
+
+	go func() {
+		if stdinW != nil {
+			defer stdinW.Close()
+		}
+		for {
+			msg, err := srv.Recv()
+			if err != nil {
+				return
+			}
+			switch m := msg.Input.(type) {
+			case *pb.ExecMessage_File:
+				if m.File == nil || m.File.Fd != 0 || stdinW == nil {
+					continue
+				}
+				if len(m.File.Data) > 0 {
+					if _, err := stdinW.Write(m.File.Data); err != nil {
+						return
+					}
+				}
+				if m.File.EOF {
+					stdinW.Close()
+				}
+			case *pb.ExecMessage_Resize:
+				// only processes with a terminal are resized
+				if m.Resize == nil || !process.Meta.Tty {
+					continue
+				}
+				select {
+				case resize <- executor.WinSize{Rows: m.Resize.Rows, Cols: m.Resize.Cols}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	if err := send(&pb.ExecMessage{Input: &pb.ExecMessage_Started{Started: &pb.StartedMessage{}}}); err != nil {
+		return err
+	}
+
+	exit := &pb.ExitMessage{}
+	if err := ctr.Exec(ctx, process); err != nil {
+		st, _ := status.FromError(errors.Cause(err))
+		exit.Error = &rpc.Status{
+			Code:    int32(st.Code()),
+			Message: err.Error(),
+		}
+	}
+	return send(&pb.ExecMessage{Input: &pb.ExecMessage_Exit{Exit: exit}})
+}
+
+func (lbf *llbBridgeForwarder) releaseContainers() {
+	lbf.mu.Lock()
+	ctrs := lbf.ctrs
+	lbf.ctrs = map[string]frontend.Container{}
+	lbf.mu.Unlock()
+	for _, ctr := range ctrs {
+		ctr.Release(context.TODO())
+	}
+}
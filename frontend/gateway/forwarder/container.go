@@ -0,0 +1,119 @@
+package forwarder
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/moby/buildkit/executor"
+	"github.com/moby/buildkit/frontend"
+	"github.com/moby/buildkit/frontend/gateway/client"
+	"github.com/moby/buildkit/util/iostream"
+	"github.com/pkg/errors"
+)
+
+func (c *bridgeClient) NewContainer(ctx context.Context, req client.NewContainerRequest) (client.Container, error) {
+	fReq := frontend.NewContainerRequest{
+		NetMode: req.NetMode,
+	}
+	for _, m := range req.Mounts {
+		fm := frontend.Mount{
+			Dest:      m.Dest,
+			Selector:  m.Selector,
+			Readonly:  m.Readonly,
+			MountType: m.MountType,
+		}
+		if m.Ref != nil {
+			r, ok := m.Ref.(*ref)
+			if !ok {
+				return nil, errors.Errorf("invalid reference type for container %T", m.Ref)
+			}
+			fm.Ref = r.CachedResult
+		}
+		fReq.Mounts = append(fReq.Mounts, fm)
+	}
+
+	ctr, err := c.FrontendLLBBridge.NewContainer(ctx, fReq)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.ctrs = append(c.ctrs, ctr)
+	c.mu.Unlock()
+	return &container{Container: ctr}, nil
+}
+
+type container struct {
+	frontend.Container
+}
+
+func (ctr *container) Start(ctx context.Context, req client.StartRequest) (client.ContainerProcess, error) {
+	resize := make(chan executor.WinSize, 1)
+	process := executor.ProcessInfo{
+		Meta: executor.Meta{
+			Args: req.Args,
+			Env:  req.Env,
+			User: req.User,
+			Cwd:  req.Cwd,
+			Tty:  req.Tty,
+		},
+		Stdout: req.Stdout,
+		Stderr: req.Stderr,
+		Resize: resize,
+	}
+	if process.Meta.Cwd == "" {
+		process.Meta.Cwd = "/"
+	}
+
+	if req.Stdin != nil {
+		if f, ok := req.Stdin.(*os.File); ok {
+			process.Stdin = f
+		} else {
+			r, w, err := iostream.StdinPipe()
+			if err != nil {
+				return nil, err
+			}
+			go func() {
+				io.Copy(w, req.Stdin)
+				w.Close()
+			}()
+			process.Stdin = r
+		}
+	}
+
+	p := &containerProcess{
+		resize: resize,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(p.done)
+		p.err = ctr.Exec(ctx, process)
+		if f, ok := process.Stdin.(*os.File); ok && f != req.Stdin {
+			f.Close()
+		}
+	}()
+	return p, nil
+}
+
+type containerProcess struct {
+	resize chan executor.WinSize
+	done   chan struct{}
+	err    error
+}
+
+func (p *containerProcess) Wait() error {
+	<-p.done
+	return p.err
+}
+
+func (p *containerProcess) Resize(ctx context.Context, size client.WinSize) error {
+	select {
+	case p.resize <- executor.WinSize{Rows: size.Rows, Cols: size.Cols}:
+		return nil
+	case <-p.done:
+		return errors.Errorf("process has exited")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
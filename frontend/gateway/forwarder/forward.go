@@ -13,6 +13,7 @@ import (
 	"github.com/moby/buildkit/util/apicaps"
 	"github.com/moby/buildkit/worker"
 	"github.com/pkg/errors"
+	"github.com/tonistiigi/fsutil"
 )
 
 func llbBridgeToGatewayClient(ctx context.Context, llbBridge frontend.FrontendLLBBridge, opts map[string]string, workerInfos []clienttypes.WorkerInfo) (*bridgeClient, error) {
@@ -33,6 +34,7 @@ type bridgeClient struct {
 	sid          string
 	exporterAttr map[string][]byte
 	refs         []*ref
+	ctrs         []frontend.Container
 	workerInfos  []clienttypes.WorkerInfo
 }
 
@@ -114,6 +116,11 @@ func (c *bridgeClient) toFrontendResult(r *client.Result) (*frontend.Result, err
 }
 
 func (c *bridgeClient) discard(err error) {
+	// containers are released first so that their processes are stopped
+	// before the refs they mount are released
+	for _, ctr := range c.ctrs {
+		ctr.Release(context.TODO())
+	}
 	for _, r := range c.refs {
 		if r != nil {
 			if _, ok := c.final[r]; !ok || err != nil {
@@ -144,6 +151,25 @@ func (r *ref) ReadFile(ctx context.Context, req client.ReadRequest) ([]byte, err
 	return cache.ReadFile(ctx, ref, newReq)
 }
 
+func (r *ref) ReadDir(ctx context.Context, req client.ReadDirRequest) ([]*fsutil.Stat, error) {
+	ref, err := r.getImmutableRef()
+	if err != nil {
+		return nil, err
+	}
+	return cache.ReadDir(ctx, ref, cache.ReadDirRequest{
+		Path:           req.Path,
+		IncludePattern: req.IncludePattern,
+	})
+}
+
+func (r *ref) StatFile(ctx context.Context, req client.StatRequest) (*fsutil.Stat, error) {
+	ref, err := r.getImmutableRef()
+	if err != nil {
+		return nil, err
+	}
+	return cache.StatFile(ctx, ref, req.Path)
+}
+
 func (r *ref) getImmutableRef() (cache.ImmutableRef, error) {
 	ref, ok := r.CachedResult.Sys().(*worker.WorkerRef)
 	if !ok {
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/docker/distribution/reference"
+	"github.com/golang/protobuf/ptypes/any"
 	apitypes "github.com/moby/buildkit/api/types"
 	"github.com/moby/buildkit/cache"
 	"github.com/moby/buildkit/client"
@@ -24,6 +25,7 @@ import (
 	"github.com/moby/buildkit/identity"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/errdefs"
 	opspb "github.com/moby/buildkit/solver/pb"
 	"github.com/moby/buildkit/util/apicaps"
 	"github.com/moby/buildkit/util/tracing"
@@ -215,6 +217,8 @@ func (gf *gatewayFrontend) Solve(ctx context.Context, llbBridge frontend.Fronten
 }
 
 func (lbf *llbBridgeForwarder) Discard() {
+	lbf.releaseContainers()
+
 	lbf.mu.Lock()
 	defer lbf.mu.Unlock()
 	for id, r := range lbf.refs {
@@ -277,6 +281,7 @@ func NewBridgeForwarder(ctx context.Context, llbBridge frontend.FrontendLLBBridg
 		callCtx:   ctx,
 		llbBridge: llbBridge,
 		refs:      map[string]solver.CachedResult{},
+		ctrs:      map[string]frontend.Container{},
 		doneCh:    make(chan struct{}),
 		pipe:      newPipe(),
 		workers:   workers,
@@ -367,6 +372,7 @@ type llbBridgeForwarder struct {
 	err          error
 	exporterAttr map[string][]byte
 	workers      frontend.WorkerInfos
+	ctrs         map[string]frontend.Container
 	*pipe
 }
 
@@ -405,7 +411,7 @@ func (lbf *llbBridgeForwarder) Solve(ctx context.Context, req *pb.SolveRequest)
 		ImportCacheRefs: req.ImportCacheRefs,
 	})
 	if err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(err)
 	}
 
 	if len(res.Refs) > 0 && !req.AllowResultReturn {
@@ -472,18 +478,9 @@ func (lbf *llbBridgeForwarder) Solve(ctx context.Context, req *pb.SolveRequest)
 }
 func (lbf *llbBridgeForwarder) ReadFile(ctx context.Context, req *pb.ReadFileRequest) (*pb.ReadFileResponse, error) {
 	ctx = tracing.ContextWithSpanFromContext(ctx, lbf.callCtx)
-	lbf.mu.Lock()
-	ref, ok := lbf.refs[req.Ref]
-	lbf.mu.Unlock()
-	if !ok {
-		return nil, errors.Errorf("no such ref: %v", req.Ref)
-	}
-	if ref == nil {
-		return nil, errors.Wrapf(os.ErrNotExist, "%s no found", req.FilePath)
-	}
-	workerRef, ok := ref.Sys().(*worker.WorkerRef)
-	if !ok {
-		return nil, errors.Errorf("invalid ref: %T", ref.Sys())
+	ref, err := lbf.getImmutableRef(req.Ref, req.FilePath)
+	if err != nil {
+		return nil, err
 	}
 
 	newReq := cache.ReadRequest{
@@ -496,7 +493,7 @@ func (lbf *llbBridgeForwarder) ReadFile(ctx context.Context, req *pb.ReadFileReq
 		}
 	}
 
-	dt, err := cache.ReadFile(ctx, workerRef.ImmutableRef, newReq)
+	dt, err := cache.ReadFile(ctx, ref, newReq)
 	if err != nil {
 		return nil, err
 	}
@@ -504,6 +501,70 @@ func (lbf *llbBridgeForwarder) ReadFile(ctx context.Context, req *pb.ReadFileReq
 	return &pb.ReadFileResponse{Data: dt}, nil
 }
 
+func (lbf *llbBridgeForwarder) ReadDir(ctx context.Context, req *pb.ReadDirRequest) (*pb.ReadDirResponse, error) {
+	ctx = tracing.ContextWithSpanFromContext(ctx, lbf.callCtx)
+	ref, err := lbf.getImmutableRef(req.Ref, req.DirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := cache.ReadDir(ctx, ref, cache.ReadDirRequest{
+		Path:           req.DirPath,
+		IncludePattern: req.IncludePattern,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadDirResponse{Entries: entries}, nil
+}
+
+func (lbf *llbBridgeForwarder) StatFile(ctx context.Context, req *pb.StatFileRequest) (*pb.StatFileResponse, error) {
+	ctx = tracing.ContextWithSpanFromContext(ctx, lbf.callCtx)
+	ref, err := lbf.getImmutableRef(req.Ref, req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := cache.StatFile(ctx, ref, req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StatFileResponse{Stat: st}, nil
+}
+
+func (lbf *llbBridgeForwarder) Warn(ctx context.Context, in *pb.WarnRequest) (*pb.WarnResponse, error) {
+	ctx = tracing.ContextWithSpanFromContext(ctx, lbf.callCtx)
+	err := lbf.llbBridge.Warn(ctx, in.Digest, string(in.Short), frontend.WarnOpts{
+		Level:      int(in.Level),
+		Detail:     in.Detail,
+		SourceInfo: in.Info,
+		Range:      in.Ranges,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WarnResponse{}, nil
+}
+
+func (lbf *llbBridgeForwarder) getImmutableRef(id, path string) (cache.ImmutableRef, error) {
+	lbf.mu.Lock()
+	ref, ok := lbf.refs[id]
+	lbf.mu.Unlock()
+	if !ok {
+		return nil, errors.Errorf("no such ref: %v", id)
+	}
+	if ref == nil {
+		return nil, errors.Wrapf(os.ErrNotExist, "%s not found", path)
+	}
+	workerRef, ok := ref.Sys().(*worker.WorkerRef)
+	if !ok {
+		return nil, errors.Errorf("invalid ref: %T", ref.Sys())
+	}
+	return workerRef.ImmutableRef, nil
+}
+
 func (lbf *llbBridgeForwarder) Ping(context.Context, *pb.PingRequest) (*pb.PongResponse, error) {
 
 	workers := lbf.workers.WorkerInfos()
@@ -525,11 +586,14 @@ func (lbf *llbBridgeForwarder) Ping(context.Context, *pb.PingRequest) (*pb.PongR
 
 func (lbf *llbBridgeForwarder) Return(ctx context.Context, in *pb.ReturnRequest) (*pb.ReturnResponse, error) {
 	if in.Error != nil {
-		return lbf.setResult(nil, status.ErrorProto(&spb.Status{
+		st := &spb.Status{
 			Code:    in.Error.Code,
 			Message: in.Error.Message,
-			// Details: in.Error.Details,
-		}))
+		}
+		for _, d := range in.Error.Details {
+			st.Details = append(st.Details, &any.Any{TypeUrl: d.TypeUrl, Value: d.Value})
+		}
+		return lbf.setResult(nil, errdefs.FromGRPC(status.ErrorProto(st)))
 	} else {
 		r := &frontend.Result{
 			Metadata: in.Result.Metadata,
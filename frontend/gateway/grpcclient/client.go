@@ -10,12 +10,15 @@ import (
 	"time"
 
 	"github.com/gogo/googleapis/google/rpc"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/moby/buildkit/frontend/gateway/client"
 	pb "github.com/moby/buildkit/frontend/gateway/pb"
+	"github.com/moby/buildkit/solver/errdefs"
 	opspb "github.com/moby/buildkit/solver/pb"
 	"github.com/moby/buildkit/util/apicaps"
 	digest "github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
+	"github.com/tonistiigi/fsutil"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
 )
@@ -125,12 +128,14 @@ func (c *grpcClient) Run(ctx context.Context, f client.BuildFunc) (retError erro
 				}
 			}
 			if retError != nil {
-				st, _ := status.FromError(retError)
+				st, _ := status.FromError(errdefs.ToGRPC(retError))
 				stp := st.Proto()
 				req.Error = &rpc.Status{
 					Code:    stp.Code,
 					Message: stp.Message,
-					// Details: stp.Details,
+				}
+				for _, d := range stp.Details {
+					req.Error.Details = append(req.Error.Details, &gogotypes.Any{TypeUrl: d.TypeUrl, Value: d.Value})
 				}
 			}
 			if _, err := c.client.Return(ctx, req); err != nil && retError == nil {
@@ -162,7 +167,7 @@ func (c *grpcClient) Run(ctx context.Context, f client.BuildFunc) (retError erro
 		req.ExporterAttr = exportedAttrBytes
 
 		if _, err := c.client.Solve(ctx, req); err != nil {
-			return errors.Wrapf(err, "failed to solve")
+			return errors.Wrapf(errdefs.FromGRPC(err), "failed to solve")
 		}
 	}
 
@@ -274,7 +279,7 @@ func (c *grpcClient) Solve(ctx context.Context, creq client.SolveRequest) (*clie
 
 	resp, err := c.client.Solve(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, errdefs.FromGRPC(err)
 	}
 
 	res := &client.Result{}
@@ -323,6 +328,21 @@ func (c *grpcClient) ResolveImageConfig(ctx context.Context, ref string, opt cli
 	return resp.Digest, resp.Config, nil
 }
 
+func (c *grpcClient) Warn(ctx context.Context, dgst digest.Digest, msg string, opts client.WarnOpts) error {
+	if err := c.caps.Supports(pb.CapGatewayWarnings); err != nil {
+		return err
+	}
+	_, err := c.client.Warn(ctx, &pb.WarnRequest{
+		Digest: dgst,
+		Level:  int64(opts.Level),
+		Short:  []byte(msg),
+		Detail: opts.Detail,
+		Info:   opts.SourceInfo,
+		Ranges: opts.Range,
+	})
+	return err
+}
+
 func (c *grpcClient) BuildOpts() client.BuildOpts {
 	return client.BuildOpts{
 		Opts:      c.opts,
@@ -364,6 +384,35 @@ func (r *reference) ReadFile(ctx context.Context, req client.ReadRequest) ([]byt
 	return resp.Data, nil
 }
 
+func (r *reference) ReadDir(ctx context.Context, req client.ReadDirRequest) ([]*fsutil.Stat, error) {
+	if err := r.c.caps.Supports(pb.CapReadDir); err != nil {
+		return nil, err
+	}
+	resp, err := r.c.client.ReadDir(ctx, &pb.ReadDirRequest{
+		Ref:            r.id,
+		DirPath:        req.Path,
+		IncludePattern: req.IncludePattern,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Entries, nil
+}
+
+func (r *reference) StatFile(ctx context.Context, req client.StatRequest) (*fsutil.Stat, error) {
+	if err := r.c.caps.Supports(pb.CapStatFile); err != nil {
+		return nil, err
+	}
+	resp, err := r.c.client.StatFile(ctx, &pb.StatFileRequest{
+		Ref:  r.id,
+		Path: req.Path,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Stat, nil
+}
+
 func grpcClientConn(ctx context.Context) (context.Context, *grpc.ClientConn, error) {
 	dialOpt := grpc.WithDialer(func(addr string, d time.Duration) (net.Conn, error) {
 		return stdioConn(), nil
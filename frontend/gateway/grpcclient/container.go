@@ -0,0 +1,192 @@
+package grpcclient
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/moby/buildkit/frontend/gateway/client"
+	pb "github.com/moby/buildkit/frontend/gateway/pb"
+	"github.com/moby/buildkit/identity"
+	opspb "github.com/moby/buildkit/solver/pb"
+	"github.com/pkg/errors"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/status"
+)
+
+func (c *grpcClient) NewContainer(ctx context.Context, req client.NewContainerRequest) (client.Container, error) {
+	if err := c.caps.Supports(pb.CapGatewayExec); err != nil {
+		return nil, err
+	}
+
+	id := identity.NewID()
+	pbReq := &pb.NewContainerRequest{
+		ContainerID: id,
+		Network:     req.NetMode,
+	}
+	for _, m := range req.Mounts {
+		ref, err := convertRef(m.Ref)
+		if err != nil {
+			return nil, err
+		}
+		pbReq.Mounts = append(pbReq.Mounts, &pb.Mount{
+			Dest:      m.Dest,
+			Ref:       ref,
+			Selector:  m.Selector,
+			Readonly:  m.Readonly,
+			MountType: m.MountType,
+		})
+	}
+
+	if _, err := c.client.NewContainer(ctx, pbReq); err != nil {
+		return nil, err
+	}
+	return &container{id: id, c: c}, nil
+}
+
+type container struct {
+	id string
+	c  *grpcClient
+}
+
+func (ctr *container) Start(ctx context.Context, req client.StartRequest) (client.ContainerProcess, error) {
+	init := &pb.InitMessage{
+		ContainerID: ctr.id,
+		Meta: &opspb.Meta{
+			Args: req.Args,
+			Env:  req.Env,
+			Cwd:  req.Cwd,
+			User: req.User,
+		},
+		Tty: req.Tty,
+	}
+	if req.Stdin != nil {
+		init.Fds = append(init.Fds, 0)
+	}
+	if req.Stdout != nil {
+		init.Fds = append(init.Fds, 1)
+	}
+	if req.Stderr != nil {
+		init.Fds = append(init.Fds, 2)
+	}
+
+	// the process is killed when ctx is cancelled
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := ctr.c.client.ExecProcess(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	p := &process{
+		stream: stream,
+		done:   make(chan struct{}),
+	}
+
+	if err := p.send(&pb.ExecMessage{Input: &pb.ExecMessage_Init{Init: init}}); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	msg, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if msg.GetStarted() == nil {
+		cancel()
+		return nil, errors.Errorf("unexpected message %T when starting process", msg.Input)
+	}
+
+	if req.Stdin != nil {
+		go func() {
+			buf := make([]byte, 32*1024)
+			for {
+				n, err := req.Stdin.Read(buf)
+				if n > 0 {
+					dt := append([]byte{}, buf[:n]...)
+					if err := p.send(&pb.ExecMessage{Input: &pb.ExecMessage_File{File: &pb.FdMessage{Fd: 0, Data: dt}}}); err != nil {
+						return
+					}
+				}
+				if err != nil {
+					p.send(&pb.ExecMessage{Input: &pb.ExecMessage_File{File: &pb.FdMessage{Fd: 0, EOF: true}}})
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(p.done)
+		defer cancel()
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					err = errors.Errorf("process ended without exit status")
+				}
+				p.err = err
+				return
+			}
+			switch m := msg.Input.(type) {
+			case *pb.ExecMessage_File:
+				if m.File == nil {
+					continue
+				}
+				var w io.Writer
+				switch m.File.Fd {
+				case 1:
+					w = req.Stdout
+				case 2:
+					w = req.Stderr
+				}
+				if w != nil && len(m.File.Data) > 0 {
+					w.Write(m.File.Data)
+				}
+			case *pb.ExecMessage_Exit:
+				if m.Exit != nil && m.Exit.Error != nil {
+					p.err = status.ErrorProto(&spb.Status{
+						Code:    m.Exit.Error.Code,
+						Message: m.Exit.Error.Message,
+					})
+				}
+				return
+			}
+		}
+	}()
+
+	return p, nil
+}
+
+func (ctr *container) Release(ctx context.Context) error {
+	_, err := ctr.c.client.ReleaseContainer(ctx, &pb.ReleaseContainerRequest{ContainerID: ctr.id})
+	return err
+}
+
+type process struct {
+	mu     sync.Mutex
+	stream pb.LLBBridge_ExecProcessClient
+	done   chan struct{}
+	err    error
+}
+
+func (p *process) send(msg *pb.ExecMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stream.Send(msg)
+}
+
+func (p *process) Wait() error {
+	<-p.done
+	return p.err
+}
+
+func (p *process) Resize(ctx context.Context, size client.WinSize) error {
+	select {
+	case <-p.done:
+		return errors.Errorf("process has exited")
+	default:
+	}
+	return p.send(&pb.ExecMessage{Input: &pb.ExecMessage_Resize{Resize: &pb.ResizeMessage{Rows: size.Rows, Cols: size.Cols}}})
+}
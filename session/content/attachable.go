@@ -0,0 +1,142 @@
+package content
+
+import (
+	"context"
+
+	api "github.com/containerd/containerd/api/services/content/v1"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/gogo/protobuf/types"
+	"github.com/moby/buildkit/session"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCHeaderID is the gRPC header for the ID of the content store a request
+// is for
+const GRPCHeaderID = "buildkit-attachable-store-id"
+
+// readChunkSize is the size of the messages content is streamed in
+const readChunkSize = 1 << 20
+
+// NewAttachable returns a session attachable serving the blobs of stores,
+// e.g. OCI layout directories opened with local.NewStore. The content is
+// read-only.
+func NewAttachable(stores map[string]content.Store) session.Attachable {
+	return &attachable{stores: stores}
+}
+
+type attachable struct {
+	stores map[string]content.Store
+}
+
+func (a *attachable) Register(server *grpc.Server) {
+	api.RegisterContentServer(server, a)
+}
+
+func (a *attachable) store(ctx context.Context) (content.Store, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "request lacks metadata")
+	}
+	values := md[GRPCHeaderID]
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request lacks store ID")
+	}
+	s, ok := a.stores[values[0]]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "content store %s not found", values[0])
+	}
+	return s, nil
+}
+
+func (a *attachable) Info(ctx context.Context, req *api.InfoRequest) (*api.InfoResponse, error) {
+	s, err := a.store(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.Info(ctx, req.Digest)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.InfoResponse{
+		Info: api.Info{
+			Digest:    info.Digest,
+			Size_:     info.Size,
+			CreatedAt: info.CreatedAt,
+			UpdatedAt: info.UpdatedAt,
+			Labels:    info.Labels,
+		},
+	}, nil
+}
+
+func (a *attachable) Read(req *api.ReadContentRequest, srv api.Content_ReadServer) error {
+	ctx := srv.Context()
+	s, err := a.store(ctx)
+	if err != nil {
+		return err
+	}
+	ra, err := s.ReaderAt(ctx, ocispec.Descriptor{Digest: req.Digest})
+	if err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	defer ra.Close()
+
+	offset, size := req.Offset, req.Size_
+	if offset < 0 || offset > ra.Size() {
+		return status.Errorf(codes.OutOfRange, "read offset %d out of range", offset)
+	}
+	if size <= 0 || offset+size > ra.Size() {
+		size = ra.Size() - offset
+	}
+
+	buf := make([]byte, readChunkSize)
+	for size > 0 {
+		n := int64(len(buf))
+		if n > size {
+			n = size
+		}
+		n2, err := ra.ReadAt(buf[:n], offset)
+		if err != nil && int64(n2) != n {
+			return errors.Wrapf(err, "failed to read %s", req.Digest)
+		}
+		if err := srv.Send(&api.ReadContentResponse{Offset: offset, Data: buf[:n]}); err != nil {
+			return err
+		}
+		offset += n
+		size -= n
+	}
+	return nil
+}
+
+func (a *attachable) Update(context.Context, *api.UpdateRequest) (*api.UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "content store is read-only")
+}
+
+func (a *attachable) List(*api.ListContentRequest, api.Content_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "listing content is not supported")
+}
+
+func (a *attachable) Delete(context.Context, *api.DeleteContentRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "content store is read-only")
+}
+
+func (a *attachable) Status(context.Context, *api.StatusRequest) (*api.StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "content store is read-only")
+}
+
+func (a *attachable) ListStatuses(context.Context, *api.ListStatusesRequest) (*api.ListStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "content store is read-only")
+}
+
+func (a *attachable) Write(api.Content_WriteServer) error {
+	return status.Errorf(codes.Unimplemented, "content store is read-only")
+}
+
+func (a *attachable) Abort(context.Context, *api.AbortRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "content store is read-only")
+}
@@ -0,0 +1,41 @@
+package content
+
+import (
+	"context"
+
+	api "github.com/containerd/containerd/api/services/content/v1"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/proxy"
+	"github.com/moby/buildkit/session"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+type callerContentStore struct {
+	content.Store
+	storeID string
+}
+
+// NewCallerStore returns a content store reading from the store storeID of
+// the attachable registered on the session of c. Only Info and ReaderAt are
+// supported.
+func NewCallerStore(c session.Caller, storeID string) content.Store {
+	client := api.NewContentClient(c.Conn())
+	return &callerContentStore{
+		Store:   proxy.NewContentStore(client),
+		storeID: storeID,
+	}
+}
+
+func (cs *callerContentStore) Info(ctx context.Context, dgst digest.Digest) (content.Info, error) {
+	return cs.Store.Info(cs.withStoreID(ctx), dgst)
+}
+
+func (cs *callerContentStore) ReaderAt(ctx context.Context, desc ocispec.Descriptor) (content.ReaderAt, error) {
+	return cs.Store.ReaderAt(cs.withStoreID(ctx), desc)
+}
+
+func (cs *callerContentStore) withStoreID(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, GRPCHeaderID, cs.storeID)
+}
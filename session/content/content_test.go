@@ -0,0 +1,86 @@
+package content
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/testutil"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestContentAttachable(t *testing.T) {
+	ctx := context.TODO()
+	t.Parallel()
+	tmpDir, err := ioutil.TempDir("", "contenttest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	store, err := local.NewStore(tmpDir)
+	require.NoError(t, err)
+
+	blob := bytes.Repeat([]byte("buildkit"), readChunkSize/4)
+	desc := ocispec.Descriptor{
+		Digest: digest.FromBytes(blob),
+		Size:   int64(len(blob)),
+	}
+	err = content.WriteBlob(ctx, store, "test", bytes.NewReader(blob), desc)
+	require.NoError(t, err)
+
+	s, err := session.NewSession(ctx, "foo", "bar")
+	require.NoError(t, err)
+
+	m, err := session.NewManager()
+	require.NoError(t, err)
+
+	s.Allow(NewAttachable(map[string]content.Store{"test0": store}))
+
+	dialer := session.Dialer(testutil.TestStream(testutil.Handler(m.HandleConn)))
+
+	g, ctx := errgroup.WithContext(context.Background())
+
+	g.Go(func() error {
+		return s.Run(ctx, dialer)
+	})
+
+	g.Go(func() error {
+		c, err := m.Get(ctx, s.ID())
+		if err != nil {
+			return err
+		}
+		cs := NewCallerStore(c, "test0")
+
+		info, err := cs.Info(ctx, desc.Digest)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, desc.Size, info.Size)
+
+		dt, err := content.ReadBlob(ctx, cs, desc)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, blob, dt)
+
+		_, err = cs.Info(ctx, digest.FromBytes([]byte("missing")))
+		assert.True(t, errdefs.IsNotFound(err))
+
+		_, err = NewCallerStore(c, "test1").Info(ctx, desc.Digest)
+		assert.True(t, errdefs.IsNotFound(err))
+
+		return s.Close()
+	})
+
+	err = g.Wait()
+	require.NoError(t, err)
+}
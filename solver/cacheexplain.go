@@ -0,0 +1,179 @@
+package solver
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	digest "github.com/opencontainers/go-digest"
+)
+
+// CacheExplanation describes how the cache was checked for a vertex output
+type CacheExplanation struct {
+	Vertex digest.Digest
+	Name   string
+	Output Index
+	Cached bool
+	// Reason explains why the vertex did not match the cache
+	Reason string
+	// Inputs contains the cache keys that were queried for each input
+	Inputs []CacheExplanationInput
+}
+
+// CacheExplanationInput contains the cache keys queried for a vertex input
+type CacheExplanationInput struct {
+	Keys []digest.Digest
+	// Changed is set if the input does not match the closest previous record
+	Changed bool
+}
+
+// CacheExplainer computes the cache explanations of the vertexes of a job.
+// The explanations are only computed when Explain is called.
+type CacheExplainer []*cacheExplainer
+
+// Explain returns the cache explanations sorted by vertex name
+func (c CacheExplainer) Explain() []CacheExplanation {
+	out := make([]CacheExplanation, 0, len(c))
+	for _, ex := range c {
+		out = append(out, ex.explain())
+	}
+	sort.Slice(out, func(i, k int) bool {
+		if out[i].Name != out[k].Name {
+			return out[i].Name < out[k].Name
+		}
+		if out[i].Vertex != out[k].Vertex {
+			return out[i].Vertex < out[k].Vertex
+		}
+		return out[i].Output < out[k].Output
+	})
+	return out
+}
+
+// cacheExplainer holds the cache lookup state of an edge from the time it was
+// loaded from the cache or executed
+type cacheExplainer struct {
+	once sync.Once
+	ex   CacheExplanation
+	// keys are the cache keys of each input
+	keys [][]CacheKeyWithSelector
+	// matches are the IDs of the records matching the keys of each input, as
+	// found when the edge probed the cache
+	matches []map[string]struct{}
+}
+
+// explainCache records the cache lookup of the edge. It does not query the
+// cache but reuses the records that were matched while probing it.
+func (e *edge) explainCache(cached bool) *cacheExplainer {
+	c := &cacheExplainer{
+		ex: CacheExplanation{
+			Vertex: e.edge.Vertex.Digest(),
+			Name:   e.edge.Vertex.Name(),
+			Output: e.edge.Index,
+			Cached: cached,
+		},
+		keys:    make([][]CacheKeyWithSelector, len(e.deps)),
+		matches: make([]map[string]struct{}, len(e.deps)),
+	}
+
+	for i, dep := range e.deps {
+		var keys []CacheKeyWithSelector
+		if e.cacheMap != nil {
+			keys = withSelector(dep.keys, e.cacheMap.Deps[i].Selector)
+		}
+		if dep.slowCacheKey != nil {
+			keys = append(keys, CacheKeyWithSelector{CacheKey: *dep.slowCacheKey})
+		}
+		c.keys[i] = keys
+		if !cached {
+			c.matches[i] = make(map[string]struct{}, len(dep.keyMap))
+			for id := range dep.keyMap {
+				c.matches[i][id] = struct{}{}
+			}
+		}
+	}
+
+	switch {
+	case cached:
+	case e.op.IgnoreCache():
+		c.ex.Reason = "cache is disabled for vertex"
+	case len(e.deps) == 0:
+		c.ex.Reason = "no previous record for operation"
+	case e.cacheMap == nil:
+		c.ex.Reason = "cache map was not computed"
+	}
+	return c
+}
+
+func (c *cacheExplainer) explain() CacheExplanation {
+	c.once.Do(func() {
+		c.ex.Inputs = make([]CacheExplanationInput, len(c.keys))
+		for i, keys := range c.keys {
+			for _, k := range keys {
+				c.ex.Inputs[i].Keys = append(c.ex.Inputs[i].Keys, k.CacheKey.Digest())
+			}
+		}
+		if !c.ex.Cached && c.ex.Reason == "" {
+			c.ex.Reason = c.explainCacheMiss()
+		}
+		c.keys = nil
+		c.matches = nil
+	})
+	return c.ex
+}
+
+func (c *cacheExplainer) explainCacheMiss() string {
+	counts := map[string]int{}
+	for _, m := range c.matches {
+		for id := range m {
+			counts[id]++
+		}
+	}
+
+	ids := make([]string, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var closest string
+	for _, id := range ids {
+		if counts[id] > counts[closest] {
+			closest = id
+		}
+	}
+	if closest == "" {
+		for i := range c.ex.Inputs {
+			c.ex.Inputs[i].Changed = true
+		}
+		return "no previous record for operation with any of its inputs"
+	}
+
+	var changed []string
+	for i, m := range c.matches {
+		if _, ok := m[closest]; !ok {
+			c.ex.Inputs[i].Changed = true
+			changed = append(changed, strconv.Itoa(i))
+		}
+	}
+	if len(changed) == 0 {
+		return "previous result is no longer available"
+	}
+	if len(changed) == 1 {
+		return fmt.Sprintf("input %s changed", changed[0])
+	}
+	return fmt.Sprintf("inputs %s changed", strings.Join(changed, ", "))
+}
+
+func (e *edge) setCacheExplanation(ex *cacheExplainer) {
+	e.explanationMu.Lock()
+	e.explanation = ex
+	e.explanationMu.Unlock()
+}
+
+func (e *edge) cacheExplanation() *cacheExplainer {
+	e.explanationMu.Lock()
+	defer e.explanationMu.Unlock()
+	return e.explanation
+}
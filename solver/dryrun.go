@@ -0,0 +1,262 @@
+package solver
+
+import (
+	"context"
+
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+// DryRunOpt controls how a dry run checks the build cache
+type DryRunOpt struct {
+	// Exec returns true for vertexes that are cheap enough to be executed
+	// during a dry run so that content based cache keys can be computed from
+	// their results. Other vertexes are never executed.
+	Exec func(Vertex) bool
+	// Size returns the size of a result. Optional.
+	Size func(context.Context, Result) (int64, error)
+}
+
+// DryRunVertex is the expected state of a vertex output in a dry run
+type DryRunVertex struct {
+	Vertex digest.Digest
+	Name   string
+	Output Index
+	// Cached is true if the result would be loaded from the cache
+	Cached bool
+	// Executed is true if the vertex was executed by the dry run
+	Executed bool
+	// InputSize is the estimated size of the inputs. Only inputs that are
+	// cached or were executed by the dry run are counted.
+	InputSize int64
+}
+
+type dryRunEdge struct {
+	keys   []ExportableCacheKey
+	result Result
+	cached bool
+	// loaded is true if result has been set
+	loaded bool
+	record *CacheRecord
+	op     activeOp
+	size   *int64
+}
+
+type dryRun struct {
+	opt     DryRunOpt
+	edges   map[Edge]*dryRunEdge
+	out     []DryRunVertex
+	results []Result
+}
+
+// DryRun checks which vertexes of the graph would be loaded from the cache
+// and which would be executed without running the build. Vertexes are
+// returned in the order they would be processed.
+func (j *Job) DryRun(ctx context.Context, e Edge, opt DryRunOpt) ([]DryRunVertex, error) {
+	v, err := j.list.load(e.Vertex, nil, j)
+	if err != nil {
+		return nil, err
+	}
+	e.Vertex = v
+
+	ctx = j.Context(ctx)
+
+	d := &dryRun{opt: opt, edges: map[Edge]*dryRunEdge{}}
+	defer func() {
+		for _, r := range d.results {
+			r.Release(context.TODO())
+		}
+	}()
+
+	if _, err := d.edge(ctx, j, e); err != nil {
+		return nil, err
+	}
+	return d.out, nil
+}
+
+func (d *dryRun) edge(ctx context.Context, j *Job, e Edge) (*dryRunEdge, error) {
+	if de, ok := d.edges[e]; ok {
+		return de, nil
+	}
+
+	deps := make([]*dryRunEdge, len(e.Vertex.Inputs()))
+	for i, inp := range e.Vertex.Inputs() {
+		de, err := d.edge(ctx, j, inp)
+		if err != nil {
+			return nil, err
+		}
+		deps[i] = de
+	}
+
+	j.list.mu.RLock()
+	st, ok := j.list.actives[e.Vertex.Digest()]
+	j.list.mu.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("inactive vertex %s", e.Vertex.Digest())
+	}
+
+	de := &dryRunEdge{op: st.getEdge(e.Index).op}
+
+	var cacheMaps []*CacheMap
+	for i := 0; ; i++ {
+		resp, err := de.op.CacheMap(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		cacheMaps = append(cacheMaps, resp.CacheMap)
+		if resp.complete {
+			break
+		}
+	}
+
+	if !de.op.IgnoreCache() {
+		var records []*CacheRecord
+		for _, cm := range cacheMaps {
+			keys, err := d.query(ctx, de.op, cm, e.Index, deps)
+			if err != nil {
+				return nil, err
+			}
+			for _, k := range keys {
+				recs, err := de.op.Cache().Records(k)
+				if err != nil {
+					return nil, err
+				}
+				if len(recs) > 0 {
+					de.keys = append(de.keys, ExportableCacheKey{CacheKey: k})
+					records = append(records, recs...)
+				}
+			}
+		}
+		if len(records) > 0 {
+			de.cached = true
+			de.record = getBestResult(records)
+		}
+	}
+
+	var executed bool
+	if !de.cached && d.opt.Exec != nil && d.opt.Exec(e.Vertex) && len(deps) == 0 {
+		res, _, err := de.op.Exec(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for i, r := range res {
+			if i == int(e.Index) {
+				de.result = r
+			}
+			d.results = append(d.results, r)
+		}
+		if de.result == nil {
+			return nil, errors.Errorf("invalid response from exec need %d index but %d results received", e.Index, len(res))
+		}
+		de.loaded = true
+		executed = true
+	}
+
+	var inputSize int64
+	for _, dep := range deps {
+		sz, err := d.size(ctx, dep)
+		if err != nil {
+			return nil, err
+		}
+		inputSize += sz
+	}
+
+	d.out = append(d.out, DryRunVertex{
+		Vertex:    e.Vertex.Digest(),
+		Name:      e.Vertex.Name(),
+		Output:    e.Index,
+		Cached:    de.cached,
+		Executed:  executed,
+		InputSize: inputSize,
+	})
+	d.edges[e] = de
+	return de, nil
+}
+
+// query returns the cache keys that match the cache map and the keys of the
+// dependencies
+func (d *dryRun) query(ctx context.Context, op activeOp, cm *CacheMap, index Index, deps []*dryRunEdge) ([]*CacheKey, error) {
+	if len(deps) == 0 {
+		return op.Cache().Query(nil, 0, cm.Digest, index)
+	}
+
+	var matches map[string]*CacheKey
+	for i, dep := range deps {
+		inp := withSelector(dep.keys, cm.Deps[i].Selector)
+		if f := cm.Deps[i].ComputeDigestFunc; f != nil {
+			res, err := d.result(ctx, dep)
+			if err != nil {
+				return nil, err
+			}
+			if res != nil {
+				dgst, err := op.CalcSlowCache(ctx, Index(i), f, res)
+				if err != nil {
+					return nil, err
+				}
+				k := NewCacheKey(dgst, -1)
+				inp = append(inp, CacheKeyWithSelector{CacheKey: ExportableCacheKey{CacheKey: k}})
+			}
+		}
+		if len(inp) == 0 {
+			return nil, nil
+		}
+		keys, err := op.Cache().Query(inp, Index(i), cm.Digest, index)
+		if err != nil {
+			return nil, err
+		}
+		m := map[string]*CacheKey{}
+		for _, k := range keys {
+			if _, ok := matches[k.ID]; ok || matches == nil {
+				m[k.ID] = k
+			}
+		}
+		if len(m) == 0 {
+			return nil, nil
+		}
+		matches = m
+	}
+
+	out := make([]*CacheKey, 0, len(matches))
+	for _, k := range matches {
+		out = append(out, k)
+	}
+	return out, nil
+}
+
+// result returns the result of a cached or executed dependency
+func (d *dryRun) result(ctx context.Context, de *dryRunEdge) (Result, error) {
+	if de.loaded {
+		return de.result, nil
+	}
+	de.loaded = true
+	if !de.cached {
+		return nil, nil
+	}
+	res, err := de.op.LoadCache(ctx, de.record)
+	if err != nil {
+		return nil, err
+	}
+	de.result = res
+	d.results = append(d.results, res)
+	return res, nil
+}
+
+func (d *dryRun) size(ctx context.Context, de *dryRunEdge) (int64, error) {
+	if de.size != nil {
+		return *de.size, nil
+	}
+	var sz int64
+	if d.opt.Size != nil {
+		res, err := d.result(ctx, de)
+		if err != nil {
+			return 0, err
+		}
+		if res != nil {
+			if sz, err = d.opt.Size(ctx, res); err != nil {
+				return 0, err
+			}
+		}
+	}
+	de.size = &sz
+	return sz, nil
+}
@@ -64,6 +64,9 @@ type edge struct {
 	index         *edgeIndex
 
 	secondaryExporters []expDep
+
+	explanationMu sync.Mutex
+	explanation   *cacheExplainer
 }
 
 // dep holds state for a dependant edge
@@ -104,6 +107,8 @@ type edgeState struct {
 	result   *SharedCachedResult
 	cacheMap *CacheMap
 	keys     []ExportableCacheKey
+	// cached is set if the result was loaded from the cache
+	cached bool
 }
 
 type edgeRequest struct {
@@ -204,7 +209,7 @@ func (e *edge) probeCache(d *dep, depKeys []CacheKeyWithSelector) bool {
 
 // checkDepMatchPossible checks if any cache matches are possible past this point
 func (e *edge) checkDepMatchPossible(dep *dep) {
-	depHasSlowCache := e.cacheMap.Deps[dep.index].ComputeDigestFunc != nil
+	depHasSlowCache := e.slowCacheFunc(dep) != nil
 	if !e.noCacheMatchPossible && (((!dep.slowCacheFoundKey && dep.slowCacheComplete && depHasSlowCache) || (!depHasSlowCache && dep.state >= edgeStatusCacheSlow)) && len(dep.keyMap) == 0) {
 		e.noCacheMatchPossible = true
 	}
@@ -215,7 +220,11 @@ func (e *edge) slowCacheFunc(dep *dep) ResultBasedCacheFunc {
 	if e.cacheMap == nil {
 		return nil
 	}
-	return e.cacheMap.Deps[int(dep.index)].ComputeDigestFunc
+	d := e.cacheMap.Deps[int(dep.index)]
+	if d.ComputeDigestExecutedOnly && dep.state == edgeStatusComplete && dep.cached {
+		return nil
+	}
+	return d.ComputeDigestFunc
 }
 
 // allDepsHaveKeys checks if all dependencies have at least one key. used for
@@ -780,7 +789,9 @@ func (e *edge) execIfPossible(f *pipeFactory) bool {
 			e.postpone(f)
 			return true
 		}
+		e.setCacheExplanation(e.explainCache(true))
 		e.execReq = f.NewFuncRequest(e.loadCache)
+		e.cached = true
 		for req := range e.depRequests {
 			req.Cancel()
 		}
@@ -790,7 +801,9 @@ func (e *edge) execIfPossible(f *pipeFactory) bool {
 			e.postpone(f)
 			return true
 		}
+		e.setCacheExplanation(e.explainCache(false))
 		e.execReq = f.NewFuncRequest(e.execOp)
+		e.cached = false
 		return true
 	}
 	return false
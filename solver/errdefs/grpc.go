@@ -0,0 +1,54 @@
+package errdefs
+
+import (
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/status"
+)
+
+const sourceTypeURL = "type.googleapis.com/errdefs.Source"
+
+// ToGRPC converts an error to a grpc status error that keeps the locations
+// of the failing ops in its details
+func ToGRPC(err error) error {
+	srcs := Sources(err)
+	if len(srcs) == 0 {
+		return err
+	}
+	st, _ := status.FromError(errors.Cause(err))
+	pst := &spb.Status{
+		Code:    int32(st.Code()),
+		Message: err.Error(),
+	}
+	for _, src := range srcs {
+		dt, err := src.Marshal()
+		if err != nil {
+			continue
+		}
+		pst.Details = append(pst.Details, &any.Any{TypeUrl: sourceTypeURL, Value: dt})
+	}
+	return status.ErrorProto(pst)
+}
+
+// FromGRPC restores the locations of the failing ops from the details of a
+// grpc status error
+func FromGRPC(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	details := st.Proto().Details
+	// wrap in reverse so that Sources returns them in the original order
+	for i := len(details) - 1; i >= 0; i-- {
+		if details[i].TypeUrl != sourceTypeURL {
+			continue
+		}
+		var src Source
+		if err := src.Unmarshal(details[i].Value); err != nil {
+			continue
+		}
+		err = WithSource(err, &src)
+	}
+	return err
+}
@@ -0,0 +1,111 @@
+package errdefs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// SourceError is an error of an op that has a location in a source file
+type SourceError struct {
+	Source *Source
+	error
+}
+
+func (e *SourceError) Cause() error {
+	return e.error
+}
+
+// WithSource adds the location of the failing op to an error
+func WithSource(err error, src *Source) error {
+	if err == nil {
+		return nil
+	}
+	return &SourceError{Source: src, error: err}
+}
+
+// Sources returns the locations of the failing ops from an error and the
+// errors it wraps
+func Sources(err error) []*Source {
+	var out []*Source
+	for err != nil {
+		if se, ok := err.(*SourceError); ok {
+			out = append(out, se.Source)
+		}
+		c, ok := err.(interface {
+			Cause() error
+		})
+		if !ok {
+			break
+		}
+		err = c.Cause()
+	}
+	return out
+}
+
+// Print writes the lines of the source file around the location, marking the
+// lines of the failing op
+func (s *Source) Print(w io.Writer) error {
+	if s.Info == nil || len(s.Ranges) == 0 {
+		return nil
+	}
+	lines := bytes.Split(s.Info.Data, []byte("\n"))
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	start, end := s.Ranges[0].Start.Line, s.Ranges[0].End.Line
+	for _, r := range s.Ranges {
+		if r.Start.Line < start {
+			start = r.Start.Line
+		}
+		if r.End.Line > end {
+			end = r.End.Line
+		}
+	}
+	if end < start {
+		end = start
+	}
+	if start < 1 || int(start) > len(lines) {
+		return nil
+	}
+
+	// show a few lines around the location
+	const padding = 2
+	from := start - padding
+	if from < 1 {
+		from = 1
+	}
+	to := end + padding
+	if int(to) > len(lines) {
+		to = int32(len(lines))
+	}
+
+	if _, err := fmt.Fprintf(w, "%s:%d\n--------------------\n", s.Info.Filename, start); err != nil {
+		return err
+	}
+	for i := from; i <= to; i++ {
+		prefix := "   "
+		if s.inRanges(i) {
+			prefix = ">>>"
+		}
+		if _, err := fmt.Fprintf(w, " %3d | %s %s\n", i, prefix, lines[i-1]); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w, "--------------------")
+	return err
+}
+
+func (s *Source) inRanges(line int32) bool {
+	for _, r := range s.Ranges {
+		end := r.End.Line
+		if end < r.Start.Line {
+			end = r.Start.Line
+		}
+		if line >= r.Start.Line && line <= end {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package errdefs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintSource(t *testing.T) {
+	src := &Source{
+		Info: &pb.SourceInfo{
+			Filename: "Dockerfile",
+			Data:     []byte("FROM busybox\nENV FOO=bar\nRUN false\nRUN true\nRUN true\nRUN true\n"),
+		},
+		Ranges: []*pb.Range{{Start: pb.Position{Line: 3}, End: pb.Position{Line: 3}}},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, src.Print(buf))
+	require.Equal(t, `Dockerfile:3
+--------------------
+   1 |     FROM busybox
+   2 |     ENV FOO=bar
+   3 | >>> RUN false
+   4 |     RUN true
+   5 |     RUN true
+--------------------
+`, buf.String())
+
+	src.Ranges = []*pb.Range{{Start: pb.Position{Line: 10}}}
+	buf.Reset()
+	require.NoError(t, src.Print(buf))
+	require.Equal(t, "", buf.String())
+}
+
+func TestSourceGRPC(t *testing.T) {
+	src1 := &Source{Info: &pb.SourceInfo{Filename: "foo"}, Ranges: []*pb.Range{{Start: pb.Position{Line: 1}}}}
+	src2 := &Source{Info: &pb.SourceInfo{Filename: "bar"}, Ranges: []*pb.Range{{Start: pb.Position{Line: 2}}}}
+
+	err := WithSource(WithSource(errors.New("failed"), src2), src1)
+	err = errors.Wrap(err, "wrapped")
+
+	srcs := Sources(err)
+	require.Equal(t, 2, len(srcs))
+	require.Equal(t, "foo", srcs[0].Info.Filename)
+
+	err = FromGRPC(ToGRPC(err))
+	require.Contains(t, err.Error(), "wrapped: failed")
+
+	srcs = Sources(err)
+	require.Equal(t, 2, len(srcs))
+	require.Equal(t, "foo", srcs[0].Info.Filename)
+	require.Equal(t, int32(1), srcs[0].Ranges[0].Start.Line)
+	require.Equal(t, "bar", srcs[1].Info.Filename)
+	require.Equal(t, int32(2), srcs[1].Ranges[0].Start.Line)
+
+	require.Nil(t, Sources(FromGRPC(errors.New("plain"))))
+}
@@ -3,6 +3,7 @@ package solver
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -48,6 +49,7 @@ type state struct {
 	allPw map[progress.Writer]struct{}
 
 	vtx          Vertex
+	origDigest   digest.Digest
 	clientVertex client.Vertex
 
 	mu    sync.Mutex
@@ -172,7 +174,7 @@ func (sb *subBuilder) Context(ctx context.Context) context.Context {
 
 func (sb *subBuilder) EachValue(ctx context.Context, key string, fn func(interface{}) error) error {
 	sb.mu.Lock()
-	defer sb.mu.Lock()
+	defer sb.mu.Unlock()
 	for j := range sb.jobs {
 		if err := j.EachValue(ctx, key, fn); err != nil {
 			return err
@@ -304,6 +306,7 @@ func (jl *Solver) loadUnlocked(v, parent Vertex, j *Job, cache map[Vertex]Vertex
 			allPw:        map[progress.Writer]struct{}{},
 			mpw:          progress.NewMultiWriter(progress.WithMetadata("vertex", dgst)),
 			vtx:          v,
+			origDigest:   origVtx.Digest(),
 			clientVertex: initClientVertex(v),
 			edges:        map[Index]*edge{},
 			index:        jl.index,
@@ -457,6 +460,68 @@ func (j *Job) Discard() error {
 	return nil
 }
 
+// CacheExplanations returns how the cache was checked for the vertexes of the
+// job that have been loaded from the cache or executed
+func (j *Job) CacheExplanations() []CacheExplanation {
+	return j.CacheExplainer().Explain()
+}
+
+// CacheExplainer returns a CacheExplainer for the vertexes of the job that
+// have been loaded from the cache or executed. It can be used after the job
+// has been discarded.
+func (j *Job) CacheExplainer() CacheExplainer {
+	j.list.mu.RLock()
+	defer j.list.mu.RUnlock()
+
+	var out CacheExplainer
+	seen := map[*edge]struct{}{}
+	for _, st := range j.list.actives {
+		st.mu.Lock()
+		if _, ok := st.jobs[j]; ok {
+			for _, e := range st.edges {
+				if _, ok := seen[e]; ok {
+					continue
+				}
+				seen[e] = struct{}{}
+				if ex := e.cacheExplanation(); ex != nil {
+					out = append(out, ex)
+				}
+			}
+		}
+		st.mu.Unlock()
+	}
+	return out
+}
+
+// EachOp calls fn for every vertex loaded by the job with the op it was
+// resolved to
+func (j *Job) EachOp(fn func(Vertex, Op) error) error {
+	j.list.mu.RLock()
+	var ops []*sharedOp
+	for _, st := range j.list.actives {
+		st.mu.Lock()
+		if _, ok := st.jobs[j]; ok {
+			ops = append(ops, st.op)
+		}
+		st.mu.Unlock()
+	}
+	j.list.mu.RUnlock()
+
+	sort.Slice(ops, func(i, k int) bool {
+		return ops[i].st.vtx.Digest() < ops[k].st.vtx.Digest()
+	})
+	for _, s := range ops {
+		op, err := s.getOp()
+		if err != nil {
+			return err
+		}
+		if err := fn(s.st.vtx, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (j *Job) Context(ctx context.Context) context.Context {
 	return progress.WithProgress(ctx, j.pw)
 }
@@ -584,12 +649,15 @@ func (s *sharedOp) CalcSlowCache(ctx context.Context, index Index, f ResultBased
 		ctx = progress.WithProgress(ctx, s.st.mpw)
 		notifyStarted(ctx, &s.st.clientVertex, false)
 		notifyCompleted(ctx, &s.st.clientVertex, err, false)
-		return "", err
+		return "", wrapVertexError(err, s.st.origDigest)
 	}
 	return key.(digest.Digest), nil
 }
 
-func (s *sharedOp) CacheMap(ctx context.Context, index int) (*cacheMapResp, error) {
+func (s *sharedOp) CacheMap(ctx context.Context, index int) (resp *cacheMapResp, err error) {
+	defer func() {
+		err = wrapVertexError(err, s.st.origDigest)
+	}()
 	op, err := s.getOp()
 	if err != nil {
 		return nil, err
@@ -646,6 +714,9 @@ func (s *sharedOp) CacheMap(ctx context.Context, index int) (*cacheMapResp, erro
 }
 
 func (s *sharedOp) Exec(ctx context.Context, inputs []Result) (outputs []Result, exporters []ExportableCacheKey, err error) {
+	defer func() {
+		err = wrapVertexError(err, s.st.origDigest)
+	}()
 	op, err := s.getOp()
 	if err != nil {
 		return nil, nil, err
@@ -666,7 +737,18 @@ func (s *sharedOp) Exec(ctx context.Context, inputs []Result) (outputs []Result,
 			notifyCompleted(ctx, &s.st.clientVertex, retErr, false)
 		}()
 
-		res, err := op.Exec(ctx, inputs)
+		timeout := s.st.vtx.Options().Timeout
+		execCtx := ctx
+		if timeout > 0 {
+			var cancel func()
+			execCtx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		res, err := op.Exec(execCtx, inputs)
+		if err != nil && timeout > 0 && ctx.Err() == nil && execCtx.Err() == context.DeadlineExceeded {
+			err = &TimeoutError{Timeout: timeout}
+		}
 		complete := true
 		if err != nil {
 			canceled := false
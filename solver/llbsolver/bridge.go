@@ -15,6 +15,7 @@ import (
 	"github.com/moby/buildkit/frontend"
 	gw "github.com/moby/buildkit/frontend/gateway/client"
 	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/sourcepolicy"
 	"github.com/moby/buildkit/util/tracing"
 	"github.com/moby/buildkit/worker"
 	digest "github.com/opencontainers/go-digest"
@@ -30,6 +31,7 @@ type llbBridge struct {
 	cms                  map[string]solver.CacheManager
 	cmsMu                sync.Mutex
 	platforms            []specs.Platform
+	dryRun               *dryRunResults
 }
 
 func (b *llbBridge) Solve(ctx context.Context, req frontend.SolveRequest) (res *frontend.Result, err error) {
@@ -84,15 +86,33 @@ func (b *llbBridge) Solve(ctx context.Context, req frontend.SolveRequest) (res *
 		if err != nil {
 			return nil, err
 		}
-
-		edge, err := Load(req.Definition, ValidateEntitlements(ent), WithCacheSources(cms), RuntimePlatforms(b.platforms), WithValidateCaps())
+		pols, err := loadSourcePolicies(b.builder)
 		if err != nil {
 			return nil, err
 		}
-		ref, err := b.builder.Build(ctx, edge)
+		if err := recordDefinition(ctx, b.builder, req.Definition); err != nil {
+			return nil, err
+		}
+
+		edge, sm, err := loadWithSourceMap(req.Definition, ValidateEntitlements(ent), WithSourcePolicies(ctx, pols), WithCacheSources(cms), RuntimePlatforms(b.platforms), WithDefaultTimeout(w.DefaultTimeout()), WithValidateCaps())
 		if err != nil {
+			if denied, ok := errors.Cause(err).(*sourcepolicy.DeniedError); ok {
+				// report the denied source in the progress of the build
+				return nil, inVertexContext(b.builder.Context(ctx), denied.Identifier, "", func(context.Context) error {
+					return err
+				})
+			}
 			return nil, err
 		}
+		var ref solver.CachedResult
+		if b.dryRun != nil {
+			ref, err = b.dryRunBuild(ctx, edge)
+		} else {
+			ref, err = b.builder.Build(ctx, edge)
+		}
+		if err != nil {
+			return nil, sm.wrapError(err)
+		}
 
 		res = &frontend.Result{Ref: ref}
 	} else if req.Frontend != "" {
@@ -136,6 +156,36 @@ func (s *llbBridge) Exec(ctx context.Context, meta executor.Meta, root cache.Imm
 	return err
 }
 
+func (s *llbBridge) NewContainer(ctx context.Context, req frontend.NewContainerRequest) (frontend.Container, error) {
+	ent, err := loadEntitlements(s.builder)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateNetMode(ent, req.NetMode); err != nil {
+		return nil, err
+	}
+
+	// the container is created by the worker of its mounts
+	var w worker.Worker
+	for _, m := range req.Mounts {
+		if m.Ref == nil {
+			continue
+		}
+		wr, ok := m.Ref.Sys().(*worker.WorkerRef)
+		if !ok {
+			return nil, errors.Errorf("invalid reference for container %T", m.Ref.Sys())
+		}
+		w = wr.Worker
+		break
+	}
+	if w == nil {
+		if w, err = s.resolveWorker(); err != nil {
+			return nil, err
+		}
+	}
+	return w.NewContainer(ctx, req)
+}
+
 func (s *llbBridge) ResolveImageConfig(ctx context.Context, ref string, opt gw.ResolveImageConfigOpt) (dgst digest.Digest, config []byte, err error) {
 	w, err := s.resolveWorker()
 	if err != nil {
@@ -151,6 +201,20 @@ func (s *llbBridge) ResolveImageConfig(ctx context.Context, ref string, opt gw.R
 		id += platforms.Format(*platform)
 	}
 	err = inVertexContext(s.builder.Context(ctx), opt.LogName, id, func(ctx context.Context) error {
+		pols, err := loadSourcePolicies(s.builder)
+		if err != nil {
+			return err
+		}
+		op := sourcepolicy.ImageSourceOp(ref)
+		for _, e := range pols {
+			if _, err := e.Evaluate(ctx, op); err != nil {
+				return err
+			}
+		}
+		ref, err := sourcepolicy.ImageRef(op)
+		if err != nil {
+			return err
+		}
 		dgst, config, err = w.ResolveImageConfig(ctx, ref, opt)
 		return err
 	})
@@ -0,0 +1,48 @@
+package llbsolver
+
+import (
+	"sync"
+
+	"github.com/moby/buildkit/solver"
+	"github.com/pkg/errors"
+)
+
+// maxExplainedBuilds is the number of recent builds that cache explanations
+// are kept for
+const maxExplainedBuilds = 50
+
+type cacheExplanations struct {
+	mu   sync.Mutex
+	refs []string
+	m    map[string]solver.CacheExplainer
+}
+
+// add records the cache lookups of a build. The explanations are computed
+// when they are requested with get.
+func (c *cacheExplanations) add(ref string, j *solver.Job) {
+	exp := j.CacheExplainer()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.m == nil {
+		c.m = map[string]solver.CacheExplainer{}
+	}
+	if _, ok := c.m[ref]; !ok {
+		c.refs = append(c.refs, ref)
+	}
+	c.m[ref] = exp
+	for len(c.refs) > maxExplainedBuilds {
+		delete(c.m, c.refs[0])
+		c.refs = c.refs[1:]
+	}
+}
+
+func (c *cacheExplanations) get(ref string) ([]solver.CacheExplanation, error) {
+	c.mu.Lock()
+	exp, ok := c.m[ref]
+	c.mu.Unlock()
+	if !ok {
+		return nil, errors.Errorf("no cache explanation for build %s", ref)
+	}
+	return exp.Explain(), nil
+}
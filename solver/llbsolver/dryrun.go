@@ -0,0 +1,142 @@
+package llbsolver
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/moby/buildkit/frontend"
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/moby/buildkit/source"
+	"github.com/moby/buildkit/sourcepolicy"
+	spb "github.com/moby/buildkit/sourcepolicy/pb"
+	"github.com/moby/buildkit/util/entitlements"
+	"github.com/moby/buildkit/worker"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+// DryRun checks which vertexes of a build would be loaded from the cache and
+// which would be executed. Nothing is executed except local sources, which
+// are transferred so that the content based cache keys of the vertexes using
+// them can be computed. Frontends see empty results for the builds that
+// would need to execute a vertex.
+func (s *Solver) DryRun(ctx context.Context, id string, req frontend.SolveRequest, ent []entitlements.Entitlement, pol *spb.Policy) ([]solver.DryRunVertex, error) {
+	if req.Definition == nil && req.Frontend == "" {
+		return nil, errors.New("dry run requires a definition or a frontend")
+	}
+
+	j, err := s.solver.NewJob(id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer j.Discard()
+
+	set, err := entitlements.WhiteList(ent, s.entitlements)
+	if err != nil {
+		return nil, err
+	}
+	j.SetValue(keyEntitlements, set)
+
+	e, err := sourcepolicy.NewEngine("client", pol)
+	if err != nil {
+		return nil, err
+	}
+	j.SetValue(keySourcePolicy, e)
+
+	j.SessionID = session.FromContext(ctx)
+
+	b := s.bridge(j)
+	b.dryRun = &dryRunResults{}
+
+	res, err := b.Solve(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res.EachRef(func(ref solver.CachedResult) error {
+		go ref.Release(context.TODO())
+		return nil
+	})
+
+	return b.dryRun.vertexes(), nil
+}
+
+// dryRunBuild records the dry run of edge and builds it only if that does not
+// execute any vertexes
+func (b *llbBridge) dryRunBuild(ctx context.Context, edge solver.Edge) (solver.CachedResult, error) {
+	j, ok := b.builder.(*solver.Job)
+	if !ok {
+		return nil, errors.Errorf("dry run is not supported for %T", b.builder)
+	}
+	vtxs, err := j.DryRun(ctx, edge, solver.DryRunOpt{
+		Exec: isLocalSource,
+		Size: workerRefSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.dryRun.add(vtxs)
+
+	for _, v := range vtxs {
+		if !v.Cached && !v.Executed {
+			return nil, nil
+		}
+	}
+	return b.builder.Build(ctx, edge)
+}
+
+func isLocalSource(v solver.Vertex) bool {
+	op, ok := v.Sys().(*pb.Op)
+	if !ok {
+		return false
+	}
+	src, ok := op.Op.(*pb.Op_Source)
+	return ok && strings.HasPrefix(src.Source.Identifier, source.LocalScheme+"://")
+}
+
+func workerRefSize(ctx context.Context, res solver.Result) (int64, error) {
+	ref, ok := res.Sys().(*worker.WorkerRef)
+	if !ok {
+		return 0, errors.Errorf("invalid reference: %T", res.Sys())
+	}
+	if ref.ImmutableRef == nil {
+		return 0, nil
+	}
+	return ref.ImmutableRef.Size(ctx)
+}
+
+type dryRunKey struct {
+	dgst   digest.Digest
+	output solver.Index
+}
+
+type dryRunResults struct {
+	mu   sync.Mutex
+	seen map[dryRunKey]struct{}
+	vtxs []solver.DryRunVertex
+}
+
+func (r *dryRunResults) add(vtxs []solver.DryRunVertex) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.seen == nil {
+		r.seen = map[dryRunKey]struct{}{}
+	}
+	for _, v := range vtxs {
+		k := dryRunKey{dgst: v.Vertex, output: v.Output}
+		if _, ok := r.seen[k]; ok {
+			continue
+		}
+		r.seen[k] = struct{}{}
+		r.vtxs = append(r.vtxs, v)
+	}
+}
+
+func (r *dryRunResults) vertexes() []solver.DryRunVertex {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]solver.DryRunVertex(nil), r.vtxs...)
+}
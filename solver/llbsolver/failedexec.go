@@ -0,0 +1,134 @@
+package llbsolver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/executor"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const keyFailedExec = "llb.failedexec"
+
+// failedExecTimeout is how long the state of a failed exec is kept for
+// starting a debug shell before it is released
+const failedExecTimeout = 10 * time.Minute
+
+// FailedExec is the state of an exec that failed. The mounts of the exec are
+// kept until Release is called so that a debug shell can be started in them.
+type FailedExec struct {
+	Meta     executor.Meta
+	Root     cache.Mountable
+	Mounts   []executor.Mount
+	Executor executor.Executor
+	Release  func()
+}
+
+// FailedExecHandler is implemented by bridges of builds that keep the state
+// of a failed exec. HandleFailedExec returns true if the handler took
+// ownership of fe.
+type FailedExecHandler interface {
+	HandleFailedExec(ctx context.Context, fe *FailedExec) bool
+}
+
+// failedExecRecorder keeps the first failed exec of a job
+type failedExecRecorder struct {
+	mu sync.Mutex
+	fe *FailedExec
+}
+
+func (r *failedExecRecorder) set(fe *FailedExec) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fe != nil {
+		return false
+	}
+	r.fe = fe
+	return true
+}
+
+func (r *failedExecRecorder) take() *FailedExec {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fe := r.fe
+	r.fe = nil
+	return fe
+}
+
+func (b *llbBridge) HandleFailedExec(ctx context.Context, fe *FailedExec) bool {
+	var kept bool
+	b.builder.EachValue(ctx, keyFailedExec, func(v interface{}) error {
+		if r, ok := v.(*failedExecRecorder); ok && !kept {
+			kept = r.set(fe)
+		}
+		return nil
+	})
+	return kept
+}
+
+// failedExecs are the failed execs of recent builds, by build ref
+type failedExecs struct {
+	mu sync.Mutex
+	m  map[string]*failedExecEntry
+}
+
+type failedExecEntry struct {
+	fe    *FailedExec
+	timer *time.Timer
+}
+
+func (f *failedExecs) add(ref string, fe *FailedExec) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.m == nil {
+		f.m = map[string]*failedExecEntry{}
+	}
+	if prev, ok := f.m[ref]; ok {
+		prev.timer.Stop()
+		prev.fe.Release()
+	}
+	f.m[ref] = &failedExecEntry{
+		fe: fe,
+		timer: time.AfterFunc(failedExecTimeout, func() {
+			if fe := f.take(ref); fe != nil {
+				logrus.Debugf("releasing failed exec of build %s", ref)
+				fe.Release()
+			}
+		}),
+	}
+}
+
+func (f *failedExecs) take(ref string) *FailedExec {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	e, ok := f.m[ref]
+	if !ok {
+		return nil
+	}
+	e.timer.Stop()
+	delete(f.m, ref)
+	return e.fe
+}
+
+// DebugShell runs a process in the state of the exec that failed the build
+// ref. The build needs to have been started with keepFailedExec. The state
+// is released when the process exits, so only one shell can be started.
+func (s *Solver) DebugShell(ctx context.Context, ref string, process executor.ProcessInfo) error {
+	fe := s.failedExecs.take(ref)
+	if fe == nil {
+		return errors.Errorf("no failed exec for build %s", ref)
+	}
+	defer fe.Release()
+
+	meta := fe.Meta
+	meta.Args = process.Meta.Args
+	meta.Tty = process.Meta.Tty
+	if len(process.Meta.Env) > 0 {
+		meta.Env = append(append([]string{}, meta.Env...), process.Meta.Env...)
+	}
+	process.Meta = meta
+	return fe.Executor.Exec(ctx, fe.Root, fe.Mounts, process)
+}
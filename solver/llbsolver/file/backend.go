@@ -0,0 +1,284 @@
+package file
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/containerd/continuity/fs"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/pkg/errors"
+)
+
+// Chown is a resolved owner for the files created by a file action
+type Chown struct {
+	UID int
+	GID int
+}
+
+func timestampToTime(ts int64) *time.Time {
+	if ts == -1 {
+		return nil
+	}
+	tm := time.Unix(ts/1e9, ts%1e9)
+	return &tm
+}
+
+// rootPath resolves p inside root, following symlinks. If the last path
+// component is a symlink it is only followed when followLinks is set.
+func rootPath(root, p string, followLinks bool) (string, error) {
+	p = filepath.Join("/", p)
+	if p == "/" {
+		return root, nil
+	}
+	if followLinks {
+		return fs.RootPath(root, p)
+	}
+	d, f := filepath.Split(p)
+	ppath, err := fs.RootPath(root, d)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(ppath, f), nil
+}
+
+// Mkdir creates the directory described by action inside the root d
+func Mkdir(ctx context.Context, d string, action pb.FileActionMkDir, user *Chown) error {
+	p, err := rootPath(d, action.Path, false)
+	if err != nil {
+		return err
+	}
+
+	if action.MakeParents {
+		if err := mkdirAll(p, os.FileMode(action.Mode)&0777, user, timestampToTime(action.Timestamp)); err != nil {
+			return err
+		}
+	} else {
+		if err := os.Mkdir(p, os.FileMode(action.Mode)&0777); err != nil {
+			if os.IsExist(err) {
+				return nil
+			}
+			return err
+		}
+		if err := setMetadata(p, os.FileMode(action.Mode)&0777, user, timestampToTime(action.Timestamp)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Mkfile writes the file described by action inside the root d
+func Mkfile(ctx context.Context, d string, action pb.FileActionMkFile, user *Chown) error {
+	p, err := rootPath(d, action.Path, true)
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(p, action.Data, os.FileMode(action.Mode)&0777); err != nil {
+		return err
+	}
+
+	return setMetadata(p, os.FileMode(action.Mode)&0777, user, timestampToTime(action.Timestamp))
+}
+
+// Rm removes the paths described by action inside the root d
+func Rm(ctx context.Context, d string, action pb.FileActionRm) error {
+	if action.AllowWildcard {
+		m, err := resolveWildcards(d, cleanPath(action.Path))
+		if err != nil {
+			return err
+		}
+		if len(m) == 0 {
+			if action.AllowNotFound {
+				return nil
+			}
+			return errors.Errorf("%s: no such file or directory", action.Path)
+		}
+
+		for _, s := range m {
+			if err := rmPath(d, s, action.AllowNotFound); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return rmPath(d, action.Path, action.AllowNotFound)
+}
+
+func rmPath(root, src string, allowNotFound bool) error {
+	p, err := rootPath(root, src, false)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Lstat(p); err != nil {
+		if os.IsNotExist(err) && allowNotFound {
+			return nil
+		}
+		return err
+	}
+
+	return os.RemoveAll(p)
+}
+
+// Copy copies files from the src root to the dest root
+func Copy(ctx context.Context, src, dest string, action pb.FileActionCopy, u *Chown) error {
+	srcPath := cleanPath(action.Src)
+	destPath := cleanPath(action.Dest)
+
+	opt := copyOpt{
+		chown:         u,
+		utime:         timestampToTime(action.Timestamp),
+		followLinks:   action.FollowSymlink,
+		dirContents:   action.DirCopyContents,
+		createDest:    action.CreateDestPath,
+		attemptUnpack: action.AttemptUnpackDockerCompatibility,
+	}
+	if action.Mode != -1 {
+		m := os.FileMode(action.Mode) & 07777
+		opt.mode = &m
+	}
+
+	if !action.AllowWildcard || !containsWildcards(srcPath) {
+		return copyPath(ctx, src, srcPath, dest, destPath, opt)
+	}
+
+	m, err := resolveWildcards(src, srcPath)
+	if err != nil {
+		return err
+	}
+
+	if len(m) == 0 {
+		if action.AllowEmptyWildcard {
+			return nil
+		}
+		return errors.Errorf("%s not found", srcPath)
+	}
+
+	if len(m) > 1 {
+		opt.destIsDir = true
+	}
+
+	for _, s := range m {
+		if err := copyPath(ctx, src, s, dest, destPath, opt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cleanPath cleans p while keeping a trailing separator that marks a
+// directory destination.
+func cleanPath(s string) string {
+	s2 := filepath.Join("/", s)
+	if strings.HasSuffix(s, "/.") {
+		if s2 != "/" {
+			s2 += "/"
+		}
+		s2 += "."
+	} else if strings.HasSuffix(s, "/") && s2 != "/" {
+		s2 += "/"
+	}
+	return s2
+}
+
+func containsWildcards(name string) bool {
+	isWindows := filepath.Separator == '\\'
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		if ch == '\\' && !isWindows {
+			i++
+		} else if ch == '*' || ch == '?' || ch == '[' {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveWildcards returns the paths inside root matching the pattern p.
+// Matching is done one path component at a time so that symlinks in the
+// parent directories are resolved relative to root.
+func resolveWildcards(root, p string) ([]string, error) {
+	d1, d2 := SplitWildcards(p)
+	if d2 == "" {
+		return []string{p}, nil
+	}
+
+	dir, err := rootPath(root, d1, true)
+	if err != nil {
+		return nil, err
+	}
+
+	comps := strings.Split(strings.Trim(d2, "/"), "/")
+	out := []string{}
+
+	var walk func(rel, dir string, comps []string) error
+	walk = func(rel, dir string, comps []string) error {
+		if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return nil
+		}
+		fis, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return err
+		}
+		for _, fi := range fis {
+			ok, err := filepath.Match(comps[0], fi.Name())
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			name := filepath.Join(rel, fi.Name())
+			if len(comps) == 1 {
+				out = append(out, name)
+				continue
+			}
+			next, err := rootPath(root, name, true)
+			if err != nil {
+				return err
+			}
+			if err := walk(name, next, comps[1:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(d1, dir, comps); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SplitWildcards splits p into the leading components that contain no
+// wildcards and the remaining pattern.
+func SplitWildcards(p string) (d1, d2 string) {
+	parts := strings.Split(filepath.Join(p), string(filepath.Separator))
+	var p1, p2 []string
+	var found bool
+	for _, p := range parts {
+		if !found && containsWildcards(p) {
+			found = true
+		}
+		if p == "" {
+			p = "/"
+		}
+		if !found {
+			p1 = append(p1, p)
+		} else {
+			p2 = append(p2, p)
+		}
+	}
+	return filepath.Join(p1...), filepath.Join(p2...)
+}
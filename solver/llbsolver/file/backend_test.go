@@ -0,0 +1,190 @@
+package file
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMkdirMkfile(t *testing.T) {
+	t.Parallel()
+
+	root, err := ioutil.TempDir("", "buildkit-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	err = Mkdir(context.TODO(), root, pb.FileActionMkDir{Path: "/foo/bar", Mode: 0700, Timestamp: -1}, nil)
+	require.Error(t, err)
+
+	err = Mkdir(context.TODO(), root, pb.FileActionMkDir{Path: "/foo/bar", Mode: 0700, MakeParents: true, Timestamp: -1}, nil)
+	require.NoError(t, err)
+
+	fi, err := os.Stat(filepath.Join(root, "foo/bar"))
+	require.NoError(t, err)
+	require.True(t, fi.IsDir())
+	require.Equal(t, os.FileMode(0700), fi.Mode()&os.ModePerm)
+
+	err = Mkfile(context.TODO(), root, pb.FileActionMkFile{Path: "/foo/bar/baz", Mode: 0640, Data: []byte("data"), Timestamp: 1e9}, nil)
+	require.NoError(t, err)
+
+	fi, err = os.Stat(filepath.Join(root, "foo/bar/baz"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0640), fi.Mode()&os.ModePerm)
+	require.Equal(t, int64(1), fi.ModTime().Unix())
+
+	dt, err := ioutil.ReadFile(filepath.Join(root, "foo/bar/baz"))
+	require.NoError(t, err)
+	require.Equal(t, "data", string(dt))
+}
+
+func TestRm(t *testing.T) {
+	t.Parallel()
+
+	root, err := ioutil.TempDir("", "buildkit-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	for _, p := range []string{"foo1", "foo2", "bar"} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(root, p), nil, 0600))
+	}
+
+	err = Rm(context.TODO(), root, pb.FileActionRm{Path: "/baz"})
+	require.Error(t, err)
+
+	err = Rm(context.TODO(), root, pb.FileActionRm{Path: "/baz", AllowNotFound: true})
+	require.NoError(t, err)
+
+	err = Rm(context.TODO(), root, pb.FileActionRm{Path: "/foo*", AllowWildcard: true})
+	require.NoError(t, err)
+
+	fis, err := ioutil.ReadDir(root)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fis))
+	require.Equal(t, "bar", fis[0].Name())
+}
+
+func TestCopy(t *testing.T) {
+	t.Parallel()
+
+	src, err := ioutil.TempDir("", "buildkit-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(src)
+
+	dest, err := ioutil.TempDir("", "buildkit-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(dest)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "dir/sub"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "dir/sub/foo"), []byte("foo"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "file1"), []byte("file1"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "file2"), []byte("file2"), 0644))
+	require.NoError(t, os.Symlink("file1", filepath.Join(src, "link")))
+
+	copyAction := func(src, dest string) pb.FileActionCopy {
+		return pb.FileActionCopy{Src: src, Dest: dest, Mode: -1, Timestamp: -1}
+	}
+
+	// file to a new directory
+	a := copyAction("/file1", "/out/")
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.Error(t, err)
+
+	a.CreateDestPath = true
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.NoError(t, err)
+	requireFile(t, filepath.Join(dest, "out/file1"), "file1")
+
+	// directory contents
+	a = copyAction("/dir", "/out2")
+	a.DirCopyContents = true
+	a.CreateDestPath = true
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.NoError(t, err)
+	requireFile(t, filepath.Join(dest, "out2/sub/foo"), "foo")
+
+	// symlinks are copied as links unless followed
+	a = copyAction("/link", "/link")
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.NoError(t, err)
+	l, err := os.Readlink(filepath.Join(dest, "link"))
+	require.NoError(t, err)
+	require.Equal(t, "file1", l)
+
+	a = copyAction("/link", "/followed")
+	a.FollowSymlink = true
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.NoError(t, err)
+	requireFile(t, filepath.Join(dest, "followed"), "file1")
+
+	// wildcards with mode override
+	a = copyAction("/file*", "/out3")
+	a.AllowWildcard = true
+	a.CreateDestPath = true
+	a.Mode = 0600
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.NoError(t, err)
+	requireFile(t, filepath.Join(dest, "out3/file1"), "file1")
+	requireFile(t, filepath.Join(dest, "out3/file2"), "file2")
+	fi, err := os.Stat(filepath.Join(dest, "out3/file2"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode()&os.ModePerm)
+
+	a = copyAction("/nomatch*", "/out4")
+	a.AllowWildcard = true
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.Error(t, err)
+
+	a.AllowEmptyWildcard = true
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.NoError(t, err)
+}
+
+func TestCopyUnpack(t *testing.T) {
+	t.Parallel()
+
+	src, err := ioutil.TempDir("", "buildkit-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(src)
+
+	dest, err := ioutil.TempDir("", "buildkit-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(dest)
+
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "a/", Typeflag: tar.TypeDir, Mode: 0755}))
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "a/b", Typeflag: tar.TypeReg, Mode: 0644, Size: 3}))
+	_, err = tw.Write([]byte("abc"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "archive.tar.gz"), buf.Bytes(), 0644))
+
+	a := pb.FileActionCopy{Src: "/archive.tar.gz", Dest: "/out/", Mode: -1, Timestamp: -1, CreateDestPath: true}
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dest, "out/archive.tar.gz"))
+	require.NoError(t, err)
+
+	a.AttemptUnpackDockerCompatibility = true
+	a.Dest = "/out2/"
+	err = Copy(context.TODO(), src, dest, a, nil)
+	require.NoError(t, err)
+	requireFile(t, filepath.Join(dest, "out2/a/b"), "abc")
+}
+
+func requireFile(t *testing.T, p, content string) {
+	dt, err := ioutil.ReadFile(p)
+	require.NoError(t, err)
+	require.Equal(t, content, string(dt))
+}
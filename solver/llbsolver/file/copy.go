@@ -0,0 +1,237 @@
+package file
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type copyOpt struct {
+	chown         *Chown
+	mode          *os.FileMode
+	utime         *time.Time
+	followLinks   bool
+	dirContents   bool
+	createDest    bool
+	destIsDir     bool
+	attemptUnpack bool
+}
+
+// copyPath copies src from srcRoot to dest in destRoot following the Docker
+// COPY/ADD semantics for trailing separators and existing directories.
+func copyPath(ctx context.Context, srcRoot, src, destRoot, dest string, opt copyOpt) error {
+	srcp, err := rootPath(srcRoot, src, opt.followLinks)
+	if err != nil {
+		return err
+	}
+
+	fi, err := os.Lstat(srcp)
+	if err != nil {
+		return errors.Wrapf(err, "failed to stat %s", src)
+	}
+
+	destp, err := rootPath(destRoot, dest, true)
+	if err != nil {
+		return err
+	}
+
+	destIsDir := opt.destIsDir || strings.HasSuffix(dest, "/") || strings.HasSuffix(dest, "/.")
+	if dfi, err := os.Stat(destp); err == nil && dfi.IsDir() {
+		destIsDir = true
+	}
+
+	if fi.Mode().IsRegular() && opt.attemptUnpack {
+		ok, err := isArchive(srcp)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if err := ensureParent(destp, opt); err != nil {
+				return err
+			}
+			if err := mkdirAll(destp, 0755, opt.chown, opt.utime); err != nil {
+				return err
+			}
+			return unpack(ctx, srcp, destp, opt)
+		}
+	}
+
+	target := destp
+	if destIsDir && !(fi.IsDir() && opt.dirContents) {
+		target = filepath.Join(destp, filepath.Base(src))
+	}
+
+	if err := ensureParent(target, opt); err != nil {
+		return err
+	}
+
+	c := &copier{opt: opt, inodes: map[uint64]string{}}
+	return c.copy(ctx, srcp, target, false)
+}
+
+func ensureParent(p string, opt copyOpt) error {
+	parent := filepath.Dir(p)
+	if opt.createDest {
+		return mkdirAll(parent, 0755, opt.chown, opt.utime)
+	}
+	if _, err := os.Stat(parent); err != nil {
+		return errors.Wrapf(err, "failed to stat %s", parent)
+	}
+	return nil
+}
+
+// mkdirAll creates p and any missing parents, applying the ownership and
+// timestamp to every directory it creates.
+func mkdirAll(p string, mode os.FileMode, user *Chown, tm *time.Time) error {
+	fi, err := os.Stat(p)
+	if err == nil {
+		if fi.IsDir() {
+			return nil
+		}
+		return errors.Errorf("%s is not a directory", p)
+	}
+
+	if parent := filepath.Dir(p); parent != p {
+		if err := mkdirAll(parent, mode, user, tm); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Mkdir(p, mode); err != nil {
+		if os.IsExist(err) {
+			if fi, err := os.Stat(p); err == nil && fi.IsDir() {
+				return nil
+			}
+		}
+		return err
+	}
+
+	return setMetadata(p, mode, user, tm)
+}
+
+type copier struct {
+	opt    copyOpt
+	inodes map[uint64]string
+}
+
+func (c *copier) copy(ctx context.Context, src, target string, overwriteTargetMetadata bool) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	fi, err := os.Lstat(src)
+	if err != nil {
+		return errors.Wrapf(err, "failed to stat %s", src)
+	}
+
+	if fi.IsDir() {
+		return c.copyDirectory(ctx, src, target, fi, overwriteTargetMetadata)
+	}
+
+	if err := removeExisting(target); err != nil {
+		return err
+	}
+
+	switch mode := fi.Mode(); {
+	case mode.IsRegular():
+		if ino, ok := getLinkInode(fi); ok {
+			if link, ok := c.inodes[ino]; ok {
+				return errors.Wrapf(os.Link(link, target), "failed to create hard link %s", target)
+			}
+			c.inodes[ino] = target
+		}
+		if err := copyFile(src, target); err != nil {
+			return errors.Wrapf(err, "failed to copy file %s", src)
+		}
+	case mode&os.ModeSymlink != 0:
+		link, err := os.Readlink(src)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read link %s", src)
+		}
+		if err := os.Symlink(link, target); err != nil {
+			return errors.Wrapf(err, "failed to create symlink %s", target)
+		}
+	case mode&(os.ModeDevice|os.ModeNamedPipe) != 0:
+		if err := copyDevice(target, fi); err != nil {
+			return errors.Wrapf(err, "failed to create device %s", target)
+		}
+	default:
+		// sockets and other special files are not copied
+		return nil
+	}
+
+	return c.copyFileInfo(src, fi, target)
+}
+
+func (c *copier) copyDirectory(ctx context.Context, src, target string, fi os.FileInfo, overwriteTargetMetadata bool) error {
+	created := false
+	st, err := os.Lstat(target)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to stat %s", target)
+		}
+		created = true
+	} else if !st.IsDir() {
+		if err := os.Remove(target); err != nil {
+			return errors.Wrapf(err, "failed to remove %s", target)
+		}
+		created = true
+	}
+
+	if created {
+		if err := os.Mkdir(target, fi.Mode()&os.ModePerm); err != nil {
+			return errors.Wrapf(err, "failed to mkdir %s", target)
+		}
+	}
+
+	fis, err := ioutil.ReadDir(src)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s", src)
+	}
+
+	for _, e := range fis {
+		if err := c.copy(ctx, filepath.Join(src, e.Name()), filepath.Join(target, e.Name()), false); err != nil {
+			return err
+		}
+	}
+
+	if created || overwriteTargetMetadata {
+		return c.copyFileInfo(src, fi, target)
+	}
+	return nil
+}
+
+func removeExisting(p string) error {
+	if _, err := os.Lstat(p); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return os.RemoveAll(p)
+}
+
+func copyFile(source, target string) error {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tgt, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer tgt.Close()
+
+	_, err = io.Copy(tgt, src)
+	return err
+}
@@ -0,0 +1,133 @@
+package file
+
+import (
+	"archive/tar"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/containerd/continuity/sysx"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+func (c *copier) copyFileInfo(src string, fi os.FileInfo, name string) error {
+	st := fi.Sys().(*syscall.Stat_t)
+
+	uid, gid := int(st.Uid), int(st.Gid)
+	if c.opt.chown != nil {
+		uid, gid = c.opt.chown.UID, c.opt.chown.GID
+	}
+	if err := os.Lchown(name, uid, gid); err != nil {
+		return errors.Wrapf(err, "failed to chown %s", name)
+	}
+
+	if fi.Mode()&os.ModeSymlink == 0 {
+		m := fi.Mode()
+		if c.opt.mode != nil {
+			m = m&^07777 | *c.opt.mode
+		}
+		if err := os.Chmod(name, m&(os.ModePerm|os.ModeSetuid|os.ModeSetgid|os.ModeSticky)); err != nil {
+			return errors.Wrapf(err, "failed to chmod %s", name)
+		}
+	}
+
+	if err := copyXAttrs(name, src); err != nil {
+		return err
+	}
+
+	timespec := []unix.Timespec{unix.Timespec(st.Atim), unix.Timespec(st.Mtim)}
+	if c.opt.utime != nil {
+		ts := unix.NsecToTimespec(c.opt.utime.UnixNano())
+		timespec = []unix.Timespec{ts, ts}
+	}
+	if err := unix.UtimesNanoAt(unix.AT_FDCWD, name, timespec, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		return errors.Wrapf(err, "failed to utime %s", name)
+	}
+
+	return nil
+}
+
+func copyXAttrs(dst, src string) error {
+	xattrKeys, err := sysx.LListxattr(src)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list xattrs on %s", src)
+	}
+	for _, xattr := range xattrKeys {
+		data, err := sysx.LGetxattr(src, xattr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get xattr %q on %s", xattr, src)
+		}
+		if err := sysx.LSetxattr(dst, xattr, data, 0); err != nil {
+			return errors.Wrapf(err, "failed to set xattr %q on %s", xattr, dst)
+		}
+	}
+	return nil
+}
+
+func setMetadata(p string, mode os.FileMode, user *Chown, tm *time.Time) error {
+	if err := os.Chmod(p, mode); err != nil {
+		return errors.Wrapf(err, "failed to chmod %s", p)
+	}
+	if user != nil {
+		if err := os.Lchown(p, user.UID, user.GID); err != nil {
+			return errors.Wrapf(err, "failed to chown %s", p)
+		}
+	}
+	if tm != nil {
+		ts := unix.NsecToTimespec(tm.UnixNano())
+		if err := unix.UtimesNanoAt(unix.AT_FDCWD, p, []unix.Timespec{ts, ts}, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+			return errors.Wrapf(err, "failed to utime %s", p)
+		}
+	}
+	return nil
+}
+
+func getLinkInode(fi os.FileInfo) (uint64, bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok || st.Nlink < 2 {
+		return 0, false
+	}
+	return st.Ino, true
+}
+
+func copyDevice(dst string, fi os.FileInfo) error {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return errors.New("unsupported stat type")
+	}
+	return unix.Mknod(dst, st.Mode, int(st.Rdev))
+}
+
+func mknodFromHeader(p string, hdr *tar.Header) error {
+	mode := uint32(hdr.Mode & 07777)
+	switch hdr.Typeflag {
+	case tar.TypeBlock:
+		mode |= unix.S_IFBLK
+	case tar.TypeChar:
+		mode |= unix.S_IFCHR
+	case tar.TypeFifo:
+		mode |= unix.S_IFIFO
+	}
+	return unix.Mknod(p, mode, int(unix.Mkdev(uint32(hdr.Devmajor), uint32(hdr.Devminor))))
+}
+
+func setHeaderMetadata(p string, hdr *tar.Header, user *Chown) error {
+	uid, gid := hdr.Uid, hdr.Gid
+	if user != nil {
+		uid, gid = user.UID, user.GID
+	}
+	if err := os.Lchown(p, uid, gid); err != nil {
+		return errors.Wrapf(err, "failed to chown %s", p)
+	}
+	if hdr.Typeflag != tar.TypeSymlink {
+		if err := os.Chmod(p, hdr.FileInfo().Mode()&(os.ModePerm|os.ModeSetuid|os.ModeSetgid|os.ModeSticky)); err != nil {
+			return errors.Wrapf(err, "failed to chmod %s", p)
+		}
+	}
+	ts := unix.NsecToTimespec(headerTime(hdr.ModTime).UnixNano())
+	if err := unix.UtimesNanoAt(unix.AT_FDCWD, p, []unix.Timespec{ts, ts}, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		return errors.Wrapf(err, "failed to utime %s", p)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// +build !linux
+
+package file
+
+import (
+	"archive/tar"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func (c *copier) copyFileInfo(src string, fi os.FileInfo, name string) error {
+	if fi.Mode()&os.ModeSymlink != 0 {
+		return nil
+	}
+
+	m := fi.Mode()
+	if c.opt.mode != nil {
+		m = m&^07777 | *c.opt.mode
+	}
+	if err := os.Chmod(name, m&os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to chmod %s", name)
+	}
+
+	tm := fi.ModTime()
+	if c.opt.utime != nil {
+		tm = *c.opt.utime
+	}
+	if err := os.Chtimes(name, tm, tm); err != nil {
+		return errors.Wrapf(err, "failed to chtimes %s", name)
+	}
+	return nil
+}
+
+func setMetadata(p string, mode os.FileMode, user *Chown, tm *time.Time) error {
+	if err := os.Chmod(p, mode); err != nil {
+		return errors.Wrapf(err, "failed to chmod %s", p)
+	}
+	if tm != nil {
+		if err := os.Chtimes(p, *tm, *tm); err != nil {
+			return errors.Wrapf(err, "failed to chtimes %s", p)
+		}
+	}
+	return nil
+}
+
+func getLinkInode(fi os.FileInfo) (uint64, bool) {
+	return 0, false
+}
+
+func copyDevice(dst string, fi os.FileInfo) error {
+	return errors.New("copying devices is not supported on this platform")
+}
+
+func mknodFromHeader(p string, hdr *tar.Header) error {
+	return errors.New("creating devices is not supported on this platform")
+}
+
+func setHeaderMetadata(p string, hdr *tar.Header, user *Chown) error {
+	if hdr.Typeflag == tar.TypeSymlink {
+		return nil
+	}
+	if err := os.Chmod(p, hdr.FileInfo().Mode()&os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to chmod %s", p)
+	}
+	tm := headerTime(hdr.ModTime)
+	if err := os.Chtimes(p, tm, tm); err != nil {
+		return errors.Wrapf(err, "failed to chtimes %s", p)
+	}
+	return nil
+}
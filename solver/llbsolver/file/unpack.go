@@ -0,0 +1,214 @@
+package file
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	bzip2Magic = []byte{0x42, 0x5A, 0x68}
+	gzipMagic  = []byte{0x1F, 0x8B, 0x08}
+	xzMagic    = []byte{0xFD, 0x37, 0x7A, 0x58, 0x5A, 0x00}
+)
+
+// decompressStream detects the compression of r the same way Docker does for
+// ADD and returns a reader for the uncompressed data.
+func decompressStream(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(10)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return gz, nil
+	case bytes.HasPrefix(magic, bzip2Magic):
+		return ioutil.NopCloser(bzip2.NewReader(br)), nil
+	case bytes.HasPrefix(magic, xzMagic):
+		return xzDecompress(ctx, br)
+	default:
+		return ioutil.NopCloser(br), nil
+	}
+}
+
+func xzDecompress(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	cmd := exec.CommandContext(ctx, "xz", "-d", "-c", "-q")
+	cmd.Stdin = r
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "failed to start xz")
+	}
+	return &cmdReader{ReadCloser: out, cmd: cmd, cancel: cancel}, nil
+}
+
+type cmdReader struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	cancel func()
+}
+
+func (r *cmdReader) Close() error {
+	r.cancel()
+	r.ReadCloser.Close()
+	r.cmd.Wait()
+	return nil
+}
+
+// isArchive returns true if the file at p is a tar archive, optionally
+// compressed with gzip, bzip2 or xz.
+func isArchive(p string) (bool, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	rdr, err := decompressStream(ctx, f)
+	if err != nil {
+		return false, nil
+	}
+	defer rdr.Close()
+
+	_, err = tar.NewReader(rdr).Next()
+	return err == nil, nil
+}
+
+func unpack(ctx context.Context, src, dest string, opt copyOpt) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rdr, err := decompressStream(ctx, f)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decompress %s", src)
+	}
+	defer rdr.Close()
+
+	type dirHeader struct {
+		path string
+		hdr  *tar.Header
+	}
+	var dirs []dirHeader
+
+	tr := tar.NewReader(rdr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", src)
+		}
+
+		p, err := rootPath(dest, hdr.Name, false)
+		if err != nil {
+			return err
+		}
+		if p == dest {
+			continue
+		}
+
+		if err := mkdirAll(filepath.Dir(p), 0755, opt.chown, nil); err != nil {
+			return err
+		}
+
+		if fi, err := os.Lstat(p); err == nil {
+			if !(fi.IsDir() && hdr.Typeflag == tar.TypeDir) {
+				if err := os.RemoveAll(p); err != nil {
+					return err
+				}
+			}
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if fi, err := os.Lstat(p); err != nil || !fi.IsDir() {
+				if err := os.Mkdir(p, 0755); err != nil {
+					return err
+				}
+			}
+			dirs = append(dirs, dirHeader{path: p, hdr: hdr})
+			continue
+		case tar.TypeReg, tar.TypeRegA:
+			w, err := os.OpenFile(p, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(w, tr); err != nil {
+				w.Close()
+				return err
+			}
+			if err := w.Close(); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(hdr.Linkname, p); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			target, err := rootPath(dest, hdr.Linkname, false)
+			if err != nil {
+				return err
+			}
+			if err := os.Link(target, p); err != nil {
+				return err
+			}
+			continue
+		case tar.TypeChar, tar.TypeBlock, tar.TypeFifo:
+			if err := mknodFromHeader(p, hdr); err != nil {
+				return errors.Wrapf(err, "failed to create device %s", p)
+			}
+		default:
+			continue
+		}
+
+		if err := setHeaderMetadata(p, hdr, opt.chown); err != nil {
+			return err
+		}
+	}
+
+	// directory times are set last so that creating their contents does not
+	// change them
+	for _, d := range dirs {
+		if err := setHeaderMetadata(d.path, d.hdr, opt.chown); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func headerTime(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Unix(0, 0)
+	}
+	return t
+}
@@ -0,0 +1,84 @@
+package file
+
+import (
+	"os"
+
+	"github.com/containerd/continuity/fs"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/opencontainers/runc/libcontainer/user"
+	"github.com/pkg/errors"
+)
+
+// ReadUser resolves chopt to numeric IDs. Named users and groups are looked
+// up from /etc/passwd and /etc/group in userRoot and groupRoot.
+func ReadUser(chopt *pb.ChownOpt, userRoot, groupRoot string) (*Chown, error) {
+	if chopt == nil {
+		return nil, nil
+	}
+	var us Chown
+	if chopt.User != nil {
+		switch u := chopt.User.User.(type) {
+		case *pb.UserOpt_ByName:
+			if userRoot == "" {
+				return nil, errors.Errorf("invalid missing user mount")
+			}
+			passwdPath, err := fs.RootPath(userRoot, "/etc/passwd")
+			if err != nil {
+				return nil, err
+			}
+			ufile, err := os.Open(passwdPath)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to open passwd file")
+			}
+			defer ufile.Close()
+
+			users, err := user.ParsePasswdFilter(ufile, func(uu user.User) bool {
+				return uu.Name == u.ByName.Name
+			})
+			if err != nil {
+				return nil, err
+			}
+			if len(users) == 0 {
+				return nil, errors.Errorf("unable to find user %s", u.ByName.Name)
+			}
+			us.UID = users[0].Uid
+			us.GID = users[0].Uid
+		case *pb.UserOpt_ByID:
+			us.UID = int(u.ByID)
+			us.GID = int(u.ByID)
+		}
+	}
+
+	if chopt.Group != nil {
+		switch u := chopt.Group.User.(type) {
+		case *pb.UserOpt_ByName:
+			if groupRoot == "" {
+				return nil, errors.Errorf("invalid missing group mount")
+			}
+			groupPath, err := fs.RootPath(groupRoot, "/etc/group")
+			if err != nil {
+				return nil, err
+			}
+			gfile, err := os.Open(groupPath)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to open group file")
+			}
+			defer gfile.Close()
+
+			groups, err := user.ParseGroupFilter(gfile, func(g user.Group) bool {
+				return g.Name == u.ByName.Name
+			})
+			if err != nil {
+				return nil, err
+			}
+			if len(groups) == 0 {
+				return nil, errors.Errorf("unable to find group %s", u.ByName.Name)
+			}
+			us.GID = groups[0].Gid
+		case *pb.UserOpt_ByID:
+			us.GID = int(u.ByID)
+		}
+	}
+
+	return &us, nil
+}
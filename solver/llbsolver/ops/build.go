@@ -47,8 +47,9 @@ func (b *buildOp) CacheMap(ctx context.Context, index int) (*solver.CacheMap, bo
 	return &solver.CacheMap{
 		Digest: digest.FromBytes(dt),
 		Deps: make([]struct {
-			Selector          digest.Digest
-			ComputeDigestFunc solver.ResultBasedCacheFunc
+			Selector                  digest.Digest
+			ComputeDigestFunc         solver.ResultBasedCacheFunc
+			ComputeDigestExecutedOnly bool
 		}, len(b.v.Inputs())),
 	}, true, nil
 }
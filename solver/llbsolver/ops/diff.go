@@ -0,0 +1,93 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/moby/buildkit/worker"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+const diffCacheType = "buildkit.diff.v0"
+
+type diffOp struct {
+	op        *pb.DiffOp
+	cm        cache.Manager
+	w         worker.Worker
+	numInputs int
+}
+
+func NewDiffOp(v solver.Vertex, op *pb.Op_Diff, cm cache.Manager, w worker.Worker) (solver.Op, error) {
+	return &diffOp{
+		op:        op.Diff,
+		cm:        cm,
+		w:         w,
+		numInputs: len(v.Inputs()),
+	}, nil
+}
+
+func (d *diffOp) CacheMap(ctx context.Context, index int) (*solver.CacheMap, bool, error) {
+	dt, err := json.Marshal(struct {
+		Type string
+		Diff *pb.DiffOp
+	}{
+		Type: diffCacheType,
+		Diff: d.op,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &solver.CacheMap{
+		Digest: digest.FromBytes(dt),
+		Deps: make([]struct {
+			Selector                  digest.Digest
+			ComputeDigestFunc         solver.ResultBasedCacheFunc
+			ComputeDigestExecutedOnly bool
+		}, d.numInputs),
+	}, true, nil
+}
+
+func (d *diffOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Result, error) {
+	getRef := func(idx pb.InputIndex) (cache.ImmutableRef, error) {
+		if idx == -1 {
+			return nil, nil
+		}
+		if int(idx) >= len(inputs) {
+			return nil, errors.Errorf("invalid input %d for diff", idx)
+		}
+		workerRef, ok := inputs[idx].Sys().(*worker.WorkerRef)
+		if !ok {
+			return nil, errors.Errorf("invalid reference for diff %T at input %d", inputs[idx].Sys(), idx)
+		}
+		return workerRef.ImmutableRef, nil
+	}
+
+	var lowerIdx, upperIdx pb.InputIndex = -1, -1
+	if d.op.Lower != nil {
+		lowerIdx = d.op.Lower.Input
+	}
+	if d.op.Upper != nil {
+		upperIdx = d.op.Upper.Input
+	}
+
+	lower, err := getRef(lowerIdx)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := getRef(upperIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := d.cm.Diff(ctx, lower, upper)
+	if err != nil {
+		return nil, err
+	}
+
+	return []solver.Result{worker.NewWorkerRefResult(ref, d.w)}, nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/moby/buildkit/cache/metadata"
 	"github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/executor"
+	"github.com/moby/buildkit/frontend"
 	"github.com/moby/buildkit/identity"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/secrets"
@@ -50,19 +51,24 @@ type execOp struct {
 	md        *metadata.Store
 	exec      executor.Executor
 	w         worker.Worker
+	llbBridge frontend.FrontendLLBBridge
 	numInputs int
+	// execInputs marks the inputs that are outputs of another exec
+	execInputs []bool
 
 	cacheMounts map[string]*cacheRefShare
 }
 
-func NewExecOp(v solver.Vertex, op *pb.Op_Exec, cm cache.Manager, sm *session.Manager, md *metadata.Store, exec executor.Executor, w worker.Worker) (solver.Op, error) {
+func NewExecOp(v solver.Vertex, op *pb.Op_Exec, b frontend.FrontendLLBBridge, cm cache.Manager, sm *session.Manager, md *metadata.Store, exec executor.Executor, w worker.Worker) (solver.Op, error) {
 	return &execOp{
 		op:          op.Exec,
 		cm:          cm,
 		sm:          sm,
 		md:          md,
 		exec:        exec,
+		llbBridge:   b,
 		numInputs:   len(v.Inputs()),
+		execInputs:  execInputs(v),
 		w:           w,
 		cacheMounts: map[string]*cacheRefShare{},
 	}, nil
@@ -115,8 +121,9 @@ func (e *execOp) CacheMap(ctx context.Context, index int) (*solver.CacheMap, boo
 	cm := &solver.CacheMap{
 		Digest: digest.FromBytes(dt),
 		Deps: make([]struct {
-			Selector          digest.Digest
-			ComputeDigestFunc solver.ResultBasedCacheFunc
+			Selector                  digest.Digest
+			ComputeDigestFunc         solver.ResultBasedCacheFunc
+			ComputeDigestExecutedOnly bool
 		}, e.numInputs),
 	}
 
@@ -135,6 +142,9 @@ func (e *execOp) CacheMap(ctx context.Context, index int) (*solver.CacheMap, boo
 		}
 		if !dep.NoContentBasedHash {
 			cm.Deps[i].ComputeDigestFunc = llbsolver.NewContentHashFunc(dedupePaths(dep.Selectors))
+		} else if e.execInputs[i] {
+			cm.Deps[i].ComputeDigestFunc = llbsolver.NewContentHashFunc(nil)
+			cm.Deps[i].ComputeDigestExecutedOnly = true
 		}
 	}
 
@@ -165,6 +175,20 @@ func dedupePaths(inp []string) []string {
 	return paths
 }
 
+// execInputs returns which inputs of v are produced by an ExecOp. The outputs
+// of an exec are content hashed as a whole even when v modifies them, so that
+// when the exec runs again and produces the same files the vertexes after it
+// are still cached. Outputs loaded from the cache are not hashed.
+func execInputs(v solver.Vertex) []bool {
+	out := make([]bool, len(v.Inputs()))
+	for i, inp := range v.Inputs() {
+		if op, ok := inp.Vertex.Sys().(*pb.Op); ok {
+			_, out[i] = op.Op.(*pb.Op_Exec)
+		}
+	}
+	return out
+}
+
 type dep struct {
 	Selectors          []string
 	NoContentBasedHash bool
@@ -473,6 +497,8 @@ func (e *execOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Res
 	var readonlyRootFS bool
 
 	var outputs []cache.Ref
+	// releasers release the mounts that are not outputs
+	var releasers []func()
 
 	defer func() {
 		for _, o := range outputs {
@@ -480,6 +506,9 @@ func (e *execOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Res
 				go o.Release(context.TODO())
 			}
 		}
+		for _, r := range releasers {
+			r()
+		}
 	}()
 
 	// loop over all mounts, fill in mounts, root and outputs
@@ -532,7 +561,9 @@ func (e *execOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Res
 				if err != nil {
 					return nil, err
 				}
-				defer active.Release(context.TODO())
+				releasers = append(releasers, func() {
+					go active.Release(context.TODO())
+				})
 				mountable = active
 			}
 
@@ -545,15 +576,15 @@ func (e *execOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Res
 				return nil, err
 			}
 			mountable = mRef
-			defer func() {
+			releasers = append(releasers, func() {
 				go mRef.Release(context.TODO())
-			}()
+			})
 			if m.Output != pb.SkipOutput && ref != nil {
 				outputs = append(outputs, ref.Clone())
 			}
 
 		case pb.MountType_TMPFS:
-			mountable = newTmpfs()
+			mountable = NewTmpfs()
 
 		case pb.MountType_SECRET:
 			secretMount, err := e.getSecretMountable(ctx, m)
@@ -593,9 +624,9 @@ func (e *execOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Res
 				if err != nil {
 					return nil, err
 				}
-				defer func() {
+				releasers = append(releasers, func() {
 					go active.Release(context.TODO())
-				}()
+				})
 				root = active
 			}
 		} else {
@@ -621,6 +652,8 @@ func (e *execOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Res
 		ReadonlyRootFS: readonlyRootFS,
 		ExtraHosts:     extraHosts,
 		NetMode:        e.op.Network,
+		SecurityMode:   e.op.Security,
+		ResourceLimits: e.op.ResourceLimits,
 	}
 
 	if e.op.Meta.ProxyEnv != nil {
@@ -631,8 +664,33 @@ func (e *execOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Res
 	defer stdout.Close()
 	defer stderr.Close()
 
-	if err := e.exec.Exec(ctx, meta, root, mounts, nil, stdout, stderr); err != nil {
-		return nil, errors.Wrapf(err, "executor failed running %v", meta.Args)
+	if err := e.exec.Exec(ctx, root, mounts, executor.ProcessInfo{Meta: meta, Stdout: stdout, Stderr: stderr}); err != nil {
+		err = errors.Wrapf(err, "executor failed running %v", meta.Args)
+		if h, ok := e.llbBridge.(llbsolver.FailedExecHandler); ok && errors.Cause(err) != context.Canceled {
+			// the mounts are kept until the handler releases them, so that a
+			// debug shell can be started in the state the exec failed in
+			keep, rel := outputs, releasers
+			fe := &llbsolver.FailedExec{
+				Meta:     meta,
+				Root:     root,
+				Mounts:   mounts,
+				Executor: e.exec,
+				Release: func() {
+					for _, o := range keep {
+						if o != nil {
+							go o.Release(context.TODO())
+						}
+					}
+					for _, r := range rel {
+						r()
+					}
+				},
+			}
+			if h.HandleFailedExec(ctx, fe) {
+				outputs, releasers = nil, nil
+			}
+		}
+		return nil, err
 	}
 
 	refs := []solver.Result{}
@@ -668,7 +726,8 @@ func proxyEnvList(p *pb.ProxyEnv) []string {
 	return out
 }
 
-func newTmpfs() cache.Mountable {
+// NewTmpfs returns a mountable for an empty tmpfs
+func NewTmpfs() cache.Mountable {
 	return &tmpfs{}
 }
 
@@ -0,0 +1,372 @@
+package ops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"runtime"
+
+	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/snapshot"
+	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/llbsolver"
+	"github.com/moby/buildkit/solver/llbsolver/file"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/moby/buildkit/worker"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+const fileCacheType = "buildkit.file.v0"
+
+type fileOp struct {
+	op         *pb.FileOp
+	cm         cache.Manager
+	w          worker.Worker
+	numInputs  int
+	execInputs []bool
+}
+
+func NewFileOp(v solver.Vertex, op *pb.Op_File, cm cache.Manager, w worker.Worker) (solver.Op, error) {
+	return &fileOp{
+		op:         op.File,
+		cm:         cm,
+		w:          w,
+		numInputs:  len(v.Inputs()),
+		execInputs: execInputs(v),
+	}, nil
+}
+
+func (f *fileOp) CacheMap(ctx context.Context, index int) (*solver.CacheMap, bool, error) {
+	dt, err := json.Marshal(struct {
+		Type string
+		File *pb.FileOp
+		OS   string
+		Arch string
+	}{
+		Type: fileCacheType,
+		File: f.op,
+		OS:   runtime.GOOS,
+		Arch: runtime.GOARCH,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	cm := &solver.CacheMap{
+		Digest: digest.FromBytes(dt),
+		Deps: make([]struct {
+			Selector                  digest.Digest
+			ComputeDigestFunc         solver.ResultBasedCacheFunc
+			ComputeDigestExecutedOnly bool
+		}, f.numInputs),
+	}
+
+	deps, err := f.getInputDeps()
+	if err != nil {
+		return nil, false, err
+	}
+
+	for i, dep := range deps {
+		if dep.NoContentBasedHash {
+			if f.execInputs[i] {
+				cm.Deps[i].ComputeDigestFunc = llbsolver.NewContentHashFunc(nil)
+				cm.Deps[i].ComputeDigestExecutedOnly = true
+			}
+			continue
+		}
+		if len(dep.Selectors) != 0 {
+			dgsts := make([][]byte, 0, len(dep.Selectors))
+			for _, p := range dep.Selectors {
+				dgsts = append(dgsts, []byte(p))
+			}
+			cm.Deps[i].Selector = digest.FromBytes(bytes.Join(dgsts, []byte{0}))
+		}
+		cm.Deps[i].ComputeDigestFunc = llbsolver.NewContentHashFunc(dedupePaths(dep.Selectors))
+	}
+
+	return cm, true, nil
+}
+
+// getInputDeps returns the paths each input is read from. Inputs that are
+// used as the base of an action are modified and can't be content hashed.
+func (f *fileOp) getInputDeps() ([]dep, error) {
+	deps := make([]dep, f.numInputs)
+
+	addSelector := func(idx pb.InputIndex, sel string) {
+		// results of other actions are not inputs of the vertex
+		if idx != -1 && int(idx) < f.numInputs {
+			deps[idx].Selectors = append(deps[idx].Selectors, sel)
+		}
+	}
+
+	addOwner := func(chopt *pb.ChownOpt) {
+		if chopt == nil {
+			return
+		}
+		if chopt.User != nil {
+			if u, ok := chopt.User.User.(*pb.UserOpt_ByName); ok {
+				addSelector(u.ByName.Input, "/etc/passwd")
+			}
+		}
+		if chopt.Group != nil {
+			if u, ok := chopt.Group.User.(*pb.UserOpt_ByName); ok {
+				addSelector(u.ByName.Input, "/etc/group")
+			}
+		}
+	}
+
+	for _, action := range f.op.Actions {
+		if int(action.Input) >= f.numInputs+len(f.op.Actions) || int(action.SecondaryInput) >= f.numInputs+len(f.op.Actions) {
+			return nil, errors.Errorf("invalid input for file action %v", action)
+		}
+		if action.Input != -1 && int(action.Input) < f.numInputs {
+			deps[action.Input].NoContentBasedHash = true
+		}
+		switch a := action.Action.(type) {
+		case *pb.FileAction_Mkdir:
+			addOwner(a.Mkdir.Owner)
+		case *pb.FileAction_Mkfile:
+			addOwner(a.Mkfile.Owner)
+		case *pb.FileAction_Copy:
+			addOwner(a.Copy.Owner)
+			src := path.Join("/", a.Copy.Src)
+			if a.Copy.AllowWildcard {
+				// only the part of the path before the first wildcard is
+				// known, so everything below it is a dependency
+				d, _ := file.SplitWildcards(src)
+				src = d
+			}
+			addSelector(action.SecondaryInput, src)
+		}
+	}
+
+	return deps, nil
+}
+
+func (f *fileOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Result, error) {
+	inpRefs := make([]cache.ImmutableRef, 0, len(inputs))
+	for i, inp := range inputs {
+		workerRef, ok := inp.Sys().(*worker.WorkerRef)
+		if !ok {
+			return nil, errors.Errorf("invalid reference for file %T at input %d", inp.Sys(), i)
+		}
+		inpRefs = append(inpRefs, workerRef.ImmutableRef)
+	}
+
+	results := make([]cache.ImmutableRef, len(f.op.Actions))
+	defer func() {
+		for _, r := range results {
+			if r != nil {
+				go r.Release(context.TODO())
+			}
+		}
+	}()
+
+	getRef := func(idx pb.InputIndex) (cache.ImmutableRef, error) {
+		if idx == -1 {
+			return nil, nil
+		}
+		if int(idx) < len(inpRefs) {
+			return inpRefs[idx], nil
+		}
+		i := int(idx) - len(inpRefs)
+		if i >= len(results) || results[i] == nil {
+			return nil, errors.Errorf("invalid file action input %d", idx)
+		}
+		return results[i], nil
+	}
+
+	outputs := make([]solver.Result, 0, len(f.op.Actions))
+	outputIndexes := map[int]cache.ImmutableRef{}
+
+	for i, action := range f.op.Actions {
+		if int(action.Input) >= len(inpRefs)+i || int(action.SecondaryInput) >= len(inpRefs)+i {
+			return nil, errors.Errorf("invalid input for file action %d", i)
+		}
+
+		ref, err := f.execAction(ctx, action, getRef)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = ref
+
+		if action.Output != -1 {
+			if _, ok := outputIndexes[int(action.Output)]; ok {
+				return nil, errors.Errorf("duplicate file action output %d", action.Output)
+			}
+			outputIndexes[int(action.Output)] = ref
+		}
+	}
+
+	for i := 0; i < len(outputIndexes); i++ {
+		ref, ok := outputIndexes[i]
+		if !ok {
+			return nil, errors.Errorf("missing file action output %d", i)
+		}
+		outputs = append(outputs, worker.NewWorkerRefResult(ref.Clone(), f.w))
+	}
+
+	return outputs, nil
+}
+
+func (f *fileOp) execAction(ctx context.Context, action *pb.FileAction, getRef func(pb.InputIndex) (cache.ImmutableRef, error)) (cache.ImmutableRef, error) {
+	base, err := getRef(action.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := f.cm.New(ctx, base, cache.WithDescription(fmt.Sprintf("file %s", fileActionName(action))))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if active != nil {
+			active.Release(context.TODO())
+		}
+	}()
+
+	var mounts mountSet
+	defer mounts.unmount()
+
+	dest, err := mounts.mount(ctx, active, false)
+	if err != nil {
+		return nil, err
+	}
+
+	readUser := func(chopt *pb.ChownOpt) (*file.Chown, error) {
+		if chopt == nil {
+			return nil, nil
+		}
+		mountRoot := func(u *pb.UserOpt) (string, error) {
+			if u == nil {
+				return "", nil
+			}
+			n, ok := u.User.(*pb.UserOpt_ByName)
+			if !ok {
+				return "", nil
+			}
+			if n.ByName.Input == -1 {
+				return "", errors.Errorf("can't resolve user %s from scratch", n.ByName.Name)
+			}
+			if n.ByName.Input == action.Input {
+				return dest, nil
+			}
+			ref, err := getRef(n.ByName.Input)
+			if err != nil {
+				return "", err
+			}
+			return mounts.mount(ctx, ref, true)
+		}
+		userRoot, err := mountRoot(chopt.User)
+		if err != nil {
+			return nil, err
+		}
+		groupRoot, err := mountRoot(chopt.Group)
+		if err != nil {
+			return nil, err
+		}
+		return file.ReadUser(chopt, userRoot, groupRoot)
+	}
+
+	switch a := action.Action.(type) {
+	case *pb.FileAction_Mkdir:
+		u, err := readUser(a.Mkdir.Owner)
+		if err != nil {
+			return nil, err
+		}
+		if err := file.Mkdir(ctx, dest, *a.Mkdir, u); err != nil {
+			return nil, err
+		}
+	case *pb.FileAction_Mkfile:
+		u, err := readUser(a.Mkfile.Owner)
+		if err != nil {
+			return nil, err
+		}
+		if err := file.Mkfile(ctx, dest, *a.Mkfile, u); err != nil {
+			return nil, err
+		}
+	case *pb.FileAction_Rm:
+		if err := file.Rm(ctx, dest, *a.Rm); err != nil {
+			return nil, err
+		}
+	case *pb.FileAction_Copy:
+		u, err := readUser(a.Copy.Owner)
+		if err != nil {
+			return nil, err
+		}
+		srcRef, err := getRef(action.SecondaryInput)
+		if err != nil {
+			return nil, err
+		}
+		var src string
+		if srcRef == nil {
+			// copying from scratch, use an empty directory as source
+			if src, err = ioutil.TempDir("", "buildkit-file"); err != nil {
+				return nil, err
+			}
+			defer os.RemoveAll(src)
+		} else if src, err = mounts.mount(ctx, srcRef, true); err != nil {
+			return nil, err
+		}
+		if err := file.Copy(ctx, src, dest, *a.Copy, u); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("invalid file action %T", action.Action)
+	}
+
+	mounts.unmount()
+
+	ref, err := active.Commit(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error committing %s", active.ID())
+	}
+	active = nil
+	return ref, nil
+}
+
+func fileActionName(action *pb.FileAction) string {
+	switch a := action.Action.(type) {
+	case *pb.FileAction_Mkdir:
+		return "mkdir " + a.Mkdir.Path
+	case *pb.FileAction_Mkfile:
+		return "mkfile " + a.Mkfile.Path
+	case *pb.FileAction_Rm:
+		return "rm " + a.Rm.Path
+	case *pb.FileAction_Copy:
+		return "copy " + a.Copy.Src + " " + a.Copy.Dest
+	default:
+		return "unknown"
+	}
+}
+
+// mountSet keeps track of the local mounts made while running an action
+type mountSet struct {
+	mounters []snapshot.Mounter
+}
+
+func (ms *mountSet) mount(ctx context.Context, ref cache.Mountable, readonly bool) (string, error) {
+	m, err := ref.Mount(ctx, readonly)
+	if err != nil {
+		return "", err
+	}
+	lm := snapshot.LocalMounter(m)
+	dir, err := lm.Mount()
+	if err != nil {
+		return "", err
+	}
+	ms.mounters = append(ms.mounters, lm)
+	return dir, nil
+}
+
+func (ms *mountSet) unmount() {
+	for _, lm := range ms.mounters {
+		lm.Unmount()
+	}
+	ms.mounters = nil
+}
@@ -0,0 +1,76 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/moby/buildkit/worker"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+const mergeCacheType = "buildkit.merge.v0"
+
+type mergeOp struct {
+	op        *pb.MergeOp
+	cm        cache.Manager
+	w         worker.Worker
+	numInputs int
+}
+
+func NewMergeOp(v solver.Vertex, op *pb.Op_Merge, cm cache.Manager, w worker.Worker) (solver.Op, error) {
+	return &mergeOp{
+		op:        op.Merge,
+		cm:        cm,
+		w:         w,
+		numInputs: len(v.Inputs()),
+	}, nil
+}
+
+func (m *mergeOp) CacheMap(ctx context.Context, index int) (*solver.CacheMap, bool, error) {
+	dt, err := json.Marshal(struct {
+		Type  string
+		Merge *pb.MergeOp
+	}{
+		Type:  mergeCacheType,
+		Merge: m.op,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	// the result only depends on the layers of the inputs so there are no
+	// selectors or content based checksums
+	return &solver.CacheMap{
+		Digest: digest.FromBytes(dt),
+		Deps: make([]struct {
+			Selector                  digest.Digest
+			ComputeDigestFunc         solver.ResultBasedCacheFunc
+			ComputeDigestExecutedOnly bool
+		}, m.numInputs),
+	}, true, nil
+}
+
+func (m *mergeOp) Exec(ctx context.Context, inputs []solver.Result) ([]solver.Result, error) {
+	refs := make([]cache.ImmutableRef, 0, len(m.op.Inputs))
+	for _, inp := range m.op.Inputs {
+		if inp.Input < 0 || int(inp.Input) >= len(inputs) {
+			return nil, errors.Errorf("invalid input %d for merge", inp.Input)
+		}
+		workerRef, ok := inputs[inp.Input].Sys().(*worker.WorkerRef)
+		if !ok {
+			return nil, errors.Errorf("invalid reference for merge %T at input %d", inputs[inp.Input].Sys(), inp.Input)
+		}
+		refs = append(refs, workerRef.ImmutableRef)
+	}
+
+	ref, err := m.cm.Merge(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	return []solver.Result{worker.NewWorkerRefResult(ref, m.w)}, nil
+}
@@ -20,6 +20,8 @@ type sourceOp struct {
 	sm       *source.Manager
 	src      source.SourceInstance
 	w        worker.Worker
+	// id is the identifier of the source after the source policy was applied
+	id string
 }
 
 func NewSourceOp(_ solver.Vertex, op *pb.Op_Source, platform *pb.Platform, sm *source.Manager, w worker.Worker) (solver.Op, error) {
@@ -37,7 +39,11 @@ func (s *sourceOp) instance(ctx context.Context) (source.SourceInstance, error)
 	if s.src != nil {
 		return s.src, nil
 	}
-	id, err := source.FromLLB(s.op, s.platform)
+	op := *s.op.Source
+	if err := s.sm.Evaluate(ctx, &op); err != nil {
+		return nil, err
+	}
+	id, err := source.FromLLB(&pb.Op_Source{Source: &op}, s.platform)
 	if err != nil {
 		return nil, err
 	}
@@ -46,9 +52,26 @@ func (s *sourceOp) instance(ctx context.Context) (source.SourceInstance, error)
 		return nil, err
 	}
 	s.src = src
+	s.id = op.Identifier
 	return s.src, nil
 }
 
+// Pin returns the identifier of the source and the immutable version it was
+// resolved to. The version is empty for sources that can't be pinned or have
+// not been resolved.
+func (s *sourceOp) Pin() (string, string) {
+	s.mu.Lock()
+	src, id := s.src, s.id
+	s.mu.Unlock()
+	if id == "" {
+		id = s.op.Source.Identifier
+	}
+	if p, ok := src.(source.Pinner); ok {
+		return id, p.Pin()
+	}
+	return id, ""
+}
+
 func (s *sourceOp) CacheMap(ctx context.Context, index int) (*solver.CacheMap, bool, error) {
 	src, err := s.instance(ctx)
 	if err != nil {
@@ -0,0 +1,168 @@
+package llbsolver
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moby/buildkit/frontend"
+	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/pb"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+const keyProvenance = "llb.provenance"
+
+const buildArgPrefix = "build-arg:"
+
+// provenanceFrontendAttrs are the frontend options recorded in the
+// provenance. Other options are left out as they may contain secrets.
+var provenanceFrontendAttrs = map[string]struct{}{
+	"filename": {},
+	"target":   {},
+	"platform": {},
+}
+
+// Provenance describes how the result of a build was produced
+type Provenance struct {
+	Frontend      string            `json:"frontend,omitempty"`
+	FrontendAttrs map[string]string `json:"frontendAttrs,omitempty"`
+	// BuildArgs contains the names of the build arguments passed to the
+	// frontend. Their values are not recorded.
+	BuildArgs []string `json:"buildArgs,omitempty"`
+	// Definitions contains all LLB definitions solved for the build,
+	// including the ones created by frontends
+	Definitions []*pb.Definition   `json:"definitions,omitempty"`
+	Sources     []ProvenanceSource `json:"sources,omitempty"`
+	StartedOn   time.Time          `json:"startedOn"`
+	FinishedOn  time.Time          `json:"finishedOn"`
+}
+
+// ProvenanceSource is a source used by the build
+type ProvenanceSource struct {
+	Identifier string `json:"identifier"`
+	// Pin is the immutable version the source was resolved to, like an image
+	// digest or a commit SHA
+	Pin string `json:"pin,omitempty"`
+}
+
+// provenanceRecorder collects the definitions solved by a job, keyed by the
+// digest of their marshaled bytes so that a definition solved more than once
+// is recorded once
+type provenanceRecorder struct {
+	mu          sync.Mutex
+	definitions map[digest.Digest]*pb.Definition
+}
+
+func (r *provenanceRecorder) addDefinition(def *pb.Definition) error {
+	dt, err := def.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal definition")
+	}
+	r.mu.Lock()
+	if r.definitions == nil {
+		r.definitions = map[digest.Digest]*pb.Definition{}
+	}
+	r.definitions[digest.FromBytes(dt)] = def
+	r.mu.Unlock()
+	return nil
+}
+
+// sortedDefinitions returns the recorded definitions ordered by their digest,
+// independent of the order they were solved in
+func (r *provenanceRecorder) sortedDefinitions() []*pb.Definition {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	dgsts := make([]digest.Digest, 0, len(r.definitions))
+	for dgst := range r.definitions {
+		dgsts = append(dgsts, dgst)
+	}
+	sort.Slice(dgsts, func(i, k int) bool {
+		return dgsts[i] < dgsts[k]
+	})
+	defs := make([]*pb.Definition, 0, len(dgsts))
+	for _, dgst := range dgsts {
+		defs = append(defs, r.definitions[dgst])
+	}
+	return defs
+}
+
+// recordDefinition adds def to the provenance of the jobs using b
+func recordDefinition(ctx context.Context, b solver.Builder, def *pb.Definition) error {
+	return b.EachValue(ctx, keyProvenance, func(v interface{}) error {
+		r, ok := v.(*provenanceRecorder)
+		if !ok {
+			return errors.Errorf("invalid provenance recorder %T", v)
+		}
+		return r.addDefinition(def)
+	})
+}
+
+// pinner is implemented by ops for source vertexes
+type pinner interface {
+	Pin() (string, string)
+}
+
+// captureProvenance returns the provenance document of a build. If epoch is
+// set the times of the build are clamped to it.
+func captureProvenance(j *solver.Job, req frontend.SolveRequest, r *provenanceRecorder, started time.Time, epoch *time.Time) ([]byte, error) {
+	p := Provenance{
+		Frontend:   req.Frontend,
+		StartedOn:  started.UTC(),
+		FinishedOn: time.Now().UTC(),
+	}
+	if epoch != nil {
+		if p.StartedOn.After(*epoch) {
+			p.StartedOn = epoch.UTC()
+		}
+		if p.FinishedOn.After(*epoch) {
+			p.FinishedOn = epoch.UTC()
+		}
+	}
+
+	for k, v := range req.FrontendOpt {
+		if strings.HasPrefix(k, buildArgPrefix) {
+			p.BuildArgs = append(p.BuildArgs, strings.TrimPrefix(k, buildArgPrefix))
+			continue
+		}
+		if _, ok := provenanceFrontendAttrs[k]; ok {
+			if p.FrontendAttrs == nil {
+				p.FrontendAttrs = map[string]string{}
+			}
+			p.FrontendAttrs[k] = v
+		}
+	}
+	sort.Strings(p.BuildArgs)
+
+	if defs := r.sortedDefinitions(); len(defs) > 0 {
+		p.Definitions = defs
+	}
+
+	seen := map[ProvenanceSource]struct{}{}
+	if err := j.EachOp(func(v solver.Vertex, op solver.Op) error {
+		if pn, ok := op.(pinner); ok {
+			id, pin := pn.Pin()
+			src := ProvenanceSource{Identifier: id, Pin: pin}
+			if _, ok := seen[src]; !ok {
+				seen[src] = struct{}{}
+				p.Sources = append(p.Sources, src)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(p.Sources, func(i, k int) bool {
+		if p.Sources[i].Identifier != p.Sources[k].Identifier {
+			return p.Sources[i].Identifier < p.Sources[k].Identifier
+		}
+		return p.Sources[i].Pin < p.Sources[k].Pin
+	})
+
+	dt, err := json.Marshal(p)
+	return dt, errors.Wrap(err, "failed to marshal provenance")
+}
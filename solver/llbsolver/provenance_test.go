@@ -0,0 +1,45 @@
+package llbsolver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moby/buildkit/frontend"
+	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvenanceDefinitions(t *testing.T) {
+	t.Parallel()
+
+	s := solver.NewSolver(solver.SolverOpt{})
+	defer s.Close()
+	j, err := s.NewJob("job")
+	require.NoError(t, err)
+	defer j.Discard()
+
+	defs := []*pb.Definition{
+		{Def: [][]byte{[]byte("foo")}},
+		{Def: [][]byte{[]byte("bar")}},
+		{Def: [][]byte{[]byte("foo"), []byte("bar")}},
+	}
+	started := time.Unix(0, 0)
+
+	capture := func(order ...int) []byte {
+		r := &provenanceRecorder{}
+		for _, i := range order {
+			require.NoError(t, r.addDefinition(defs[i]))
+		}
+		dt, err := captureProvenance(j, frontend.SolveRequest{}, r, started, &started)
+		require.NoError(t, err)
+		return dt
+	}
+
+	dt := capture(0, 1, 2)
+	// definitions solved more than once or in another order give the same
+	// provenance
+	require.Equal(t, string(dt), string(capture(2, 1, 0, 1)))
+	require.Equal(t, string(dt), string(capture(1, 2, 2, 0, 0)))
+	require.NotEqual(t, string(dt), string(capture(0, 1)))
+}
@@ -10,11 +10,15 @@ import (
 	"github.com/moby/buildkit/client"
 	controlgateway "github.com/moby/buildkit/control/gateway"
 	"github.com/moby/buildkit/exporter"
+	"github.com/moby/buildkit/exporter/containerimage"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
 	"github.com/moby/buildkit/frontend"
 	"github.com/moby/buildkit/frontend/gateway"
 	"github.com/moby/buildkit/identity"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/sourcepolicy"
+	spb "github.com/moby/buildkit/sourcepolicy/pb"
 	"github.com/moby/buildkit/util/entitlements"
 	"github.com/moby/buildkit/util/progress"
 	"github.com/moby/buildkit/worker"
@@ -23,7 +27,10 @@ import (
 	"github.com/pkg/errors"
 )
 
-const keyEntitlements = "llb.entitlements"
+const (
+	keyEntitlements = "llb.entitlements"
+	keySourcePolicy = "llb.sourcepolicy"
+)
 
 type ExporterRequest struct {
 	Exporter        exporter.ExporterInstance
@@ -42,15 +49,23 @@ type Solver struct {
 	resolveCacheImporter remotecache.ResolveCacheImporterFunc
 	platforms            []specs.Platform
 	gatewayForwarder     *controlgateway.GatewayForwarder
+	entitlements         []entitlements.Entitlement
+	explanations         cacheExplanations
+	failedExecs          failedExecs
 }
 
-func New(wc *worker.Controller, f map[string]frontend.Frontend, cache solver.CacheManager, resolveCI remotecache.ResolveCacheImporterFunc, gatewayForwarder *controlgateway.GatewayForwarder) (*Solver, error) {
+func New(wc *worker.Controller, f map[string]frontend.Frontend, cache solver.CacheManager, resolveCI remotecache.ResolveCacheImporterFunc, gatewayForwarder *controlgateway.GatewayForwarder, ents []string) (*Solver, error) {
+	supported, err := supportedEntitlements(ents)
+	if err != nil {
+		return nil, err
+	}
 	s := &Solver{
 		workerController:     wc,
 		resolveWorker:        defaultResolver(wc),
 		frontends:            f,
 		resolveCacheImporter: resolveCI,
 		gatewayForwarder:     gatewayForwarder,
+		entitlements:         supported,
 	}
 
 	// executing is currently only allowed on default worker
@@ -78,6 +93,10 @@ func (s *Solver) resolver() solver.ResolveOpFunc {
 }
 
 func (s *Solver) Bridge(b solver.Builder) frontend.FrontendLLBBridge {
+	return s.bridge(b)
+}
+
+func (s *Solver) bridge(b solver.Builder) *llbBridge {
 	return &llbBridge{
 		builder:              b,
 		frontends:            s.frontends,
@@ -88,20 +107,55 @@ func (s *Solver) Bridge(b solver.Builder) frontend.FrontendLLBBridge {
 	}
 }
 
-func (s *Solver) Solve(ctx context.Context, id string, req frontend.SolveRequest, exp ExporterRequest, ent []entitlements.Entitlement) (*client.SolveResponse, error) {
+// CacheExplanations returns how the cache was checked for the vertexes of a
+// recent build
+func (s *Solver) CacheExplanations(ref string) ([]solver.CacheExplanation, error) {
+	return s.explanations.get(ref)
+}
+
+// Solve runs the build id. If keepFailedExec is set, the state of the first
+// exec that fails is kept for DebugShell when the build fails.
+func (s *Solver) Solve(ctx context.Context, id string, req frontend.SolveRequest, exp ExporterRequest, ent []entitlements.Entitlement, pol *spb.Policy, keepFailedExec bool) (_ *client.SolveResponse, retErr error) {
 	j, err := s.solver.NewJob(id)
 	if err != nil {
 		return nil, err
 	}
 
 	defer j.Discard()
+	defer s.explanations.add(id, j)
+
+	if keepFailedExec {
+		failed := &failedExecRecorder{}
+		j.SetValue(keyFailedExec, failed)
+		defer func() {
+			if fe := failed.take(); fe != nil {
+				if retErr != nil {
+					s.failedExecs.add(id, fe)
+				} else {
+					fe.Release()
+				}
+			}
+		}()
+	}
 
-	set, err := entitlements.WhiteList(ent, supportedEntitlements())
+	set, err := entitlements.WhiteList(ent, s.entitlements)
 	if err != nil {
 		return nil, err
 	}
 	j.SetValue(keyEntitlements, set)
 
+	e, err := sourcepolicy.NewEngine("client", pol)
+	if err != nil {
+		return nil, err
+	}
+	j.SetValue(keySourcePolicy, e)
+
+	prov := &provenanceRecorder{}
+	j.SetValue(keyProvenance, prov)
+	warnings := &warningRecorder{}
+	j.SetValue(keyWarnings, warnings)
+	started := time.Now()
+
 	j.SessionID = session.FromContext(ctx)
 
 	var res *frontend.Result
@@ -145,6 +199,15 @@ func (s *Solver) Solve(ctx context.Context, id string, req frontend.SolveRequest
 		if inp.Metadata == nil {
 			inp.Metadata = make(map[string][]byte)
 		}
+		epoch, err := containerimage.ParseEpoch(res.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		dt, err := captureProvenance(j, req, prov, started, epoch)
+		if err != nil {
+			return nil, err
+		}
+		inp.Metadata[exptypes.ExporterProvenanceKey] = dt
 		if res := res.Ref; res != nil {
 			workerRef, ok := res.Sys().(*worker.WorkerRef)
 			if !ok {
@@ -208,6 +271,7 @@ func (s *Solver) Solve(ctx context.Context, id string, req frontend.SolveRequest
 
 	return &client.SolveResponse{
 		ExporterResponse: exporterResponse,
+		Warnings:         warnings.all(),
 	}, nil
 }
 
@@ -284,14 +348,18 @@ func notifyCompleted(ctx context.Context, v *client.Vertex, err error, cached bo
 	pw.Write(v.Digest.String(), *v)
 }
 
-var AllowNetworkHostUnstable = false // TODO: enable in constructor
-
-func supportedEntitlements() []entitlements.Entitlement {
+// supportedEntitlements returns the insecure entitlements that the daemon
+// allows builds to request
+func supportedEntitlements(ents []string) ([]entitlements.Entitlement, error) {
 	out := []entitlements.Entitlement{} // nil means no filter
-	if AllowNetworkHostUnstable {
-		out = append(out, entitlements.EntitlementNetworkHost)
+	for _, e := range ents {
+		ent, err := entitlements.Parse(e)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid insecure entitlement")
+		}
+		out = append(out, ent)
 	}
-	return out
+	return out, nil
 }
 
 func loadEntitlements(b solver.Builder) (entitlements.Set, error) {
@@ -311,3 +379,19 @@ func loadEntitlements(b solver.Builder) (entitlements.Set, error) {
 	}
 	return ent, nil
 }
+
+func loadSourcePolicies(b solver.Builder) ([]*sourcepolicy.Engine, error) {
+	var engines []*sourcepolicy.Engine
+	err := b.EachValue(context.TODO(), keySourcePolicy, func(v interface{}) error {
+		e, ok := v.(*sourcepolicy.Engine)
+		if !ok {
+			return errors.Errorf("invalid source policy %T", v)
+		}
+		engines = append(engines, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return engines, nil
+}
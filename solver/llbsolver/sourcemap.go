@@ -0,0 +1,63 @@
+package llbsolver
+
+import (
+	"github.com/moby/buildkit/solver"
+	"github.com/moby/buildkit/solver/errdefs"
+	"github.com/moby/buildkit/solver/pb"
+	digest "github.com/opencontainers/go-digest"
+)
+
+// sourceMap finds the locations of the loaded vertexes of a definition in the
+// files that the definition was generated from
+type sourceMap struct {
+	source *pb.Source
+	// digests maps the digests of the loaded vertexes to the digests of the
+	// ops in the definition, that differ if a source policy changed the op
+	digests map[digest.Digest]digest.Digest
+}
+
+func newSourceMap(src *pb.Source) *sourceMap {
+	return &sourceMap{
+		source:  src,
+		digests: map[digest.Digest]digest.Digest{},
+	}
+}
+
+func (sm *sourceMap) add(vtx, op digest.Digest) {
+	if sm.source == nil {
+		return
+	}
+	sm.digests[vtx] = op
+}
+
+// wrapError adds the locations of the vertex that caused err to it
+func (sm *sourceMap) wrapError(err error) error {
+	if sm.source == nil {
+		return err
+	}
+	for e := err; e != nil; {
+		if ve, ok := e.(*solver.VertexError); ok {
+			if locs, ok := sm.source.Locations[sm.digests[ve.Digest]]; ok {
+				for i := len(locs.Locations) - 1; i >= 0; i-- {
+					l := locs.Locations[i]
+					if l.SourceIndex < 0 || int(l.SourceIndex) >= len(sm.source.Infos) {
+						continue
+					}
+					err = errdefs.WithSource(err, &errdefs.Source{
+						Info:   sm.source.Infos[l.SourceIndex],
+						Ranges: l.Ranges,
+					})
+				}
+				return err
+			}
+		}
+		c, ok := e.(interface {
+			Cause() error
+		})
+		if !ok {
+			break
+		}
+		e = c.Cause()
+	}
+	return err
+}
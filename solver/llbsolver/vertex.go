@@ -1,12 +1,16 @@
 package llbsolver
 
 import (
+	"context"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/containerd/containerd/platforms"
 	"github.com/moby/buildkit/solver"
 	"github.com/moby/buildkit/solver/pb"
 	"github.com/moby/buildkit/source"
+	"github.com/moby/buildkit/sourcepolicy"
 	"github.com/moby/buildkit/util/entitlements"
 	digest "github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
@@ -100,18 +104,26 @@ func RuntimePlatforms(p []specs.Platform) LoadOpt {
 	}
 }
 
+// WithDefaultTimeout sets the timeout for vertexes that don't define their own
+func WithDefaultTimeout(d time.Duration) LoadOpt {
+	return func(_ *pb.Op, _ *pb.OpMetadata, opt *solver.VertexOptions) error {
+		if opt.Timeout == 0 {
+			opt.Timeout = d
+		}
+		return nil
+	}
+}
+
 func ValidateEntitlements(ent entitlements.Set) LoadOpt {
 	return func(op *pb.Op, _ *pb.OpMetadata, opt *solver.VertexOptions) error {
 		switch op := op.Op.(type) {
 		case *pb.Op_Exec:
-			if op.Exec.Network == pb.NetMode_HOST {
-				if !ent.Allowed(entitlements.EntitlementNetworkHost) {
-					return errors.Errorf("%s is not allowed", entitlements.EntitlementNetworkHost)
-				}
+			if err := validateNetMode(ent, op.Exec.Network); err != nil {
+				return err
 			}
-			if op.Exec.Network == pb.NetMode_NONE {
-				if !ent.Allowed(entitlements.EntitlementNetworkNone) {
-					return errors.Errorf("%s is not allowed", entitlements.EntitlementNetworkNone)
+			if op.Exec.Security == pb.SecurityMode_INSECURE {
+				if !ent.Allowed(entitlements.EntitlementSecurityUnconfined) {
+					return errors.Errorf("%s is not allowed", entitlements.EntitlementSecurityUnconfined)
 				}
 			}
 		}
@@ -119,15 +131,57 @@ func ValidateEntitlements(ent entitlements.Set) LoadOpt {
 	}
 }
 
+// validateNetMode checks that the network mode of a process is allowed by the
+// entitlements of the build
+func validateNetMode(ent entitlements.Set, mode pb.NetMode) error {
+	switch mode {
+	case pb.NetMode_HOST:
+		if !ent.Allowed(entitlements.EntitlementNetworkHost) {
+			return errors.Errorf("%s is not allowed", entitlements.EntitlementNetworkHost)
+		}
+	case pb.NetMode_NONE:
+		if !ent.Allowed(entitlements.EntitlementNetworkNone) {
+			return errors.Errorf("%s is not allowed", entitlements.EntitlementNetworkNone)
+		}
+	}
+	return nil
+}
+
+// WithSourcePolicies applies the source policies of the build to source ops.
+// Policies are applied in order so that later policies see the sources
+// converted by earlier ones.
+func WithSourcePolicies(ctx context.Context, engines []*sourcepolicy.Engine) LoadOpt {
+	return func(op *pb.Op, _ *pb.OpMetadata, _ *solver.VertexOptions) error {
+		src, ok := op.Op.(*pb.Op_Source)
+		if !ok {
+			return nil
+		}
+		for _, e := range engines {
+			if _, err := e.Evaluate(ctx, src.Source); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 func Load(def *pb.Definition, opts ...LoadOpt) (solver.Edge, error) {
-	return loadLLB(def, func(dgst digest.Digest, pbOp *pb.Op, load func(digest.Digest) (solver.Vertex, error)) (solver.Vertex, error) {
+	e, _, err := loadWithSourceMap(def, opts...)
+	return e, err
+}
+
+func loadWithSourceMap(def *pb.Definition, opts ...LoadOpt) (solver.Edge, *sourceMap, error) {
+	sm := newSourceMap(def.Source)
+	e, err := loadLLB(def, func(dgst digest.Digest, pbOp *pb.Op, load func(digest.Digest) (solver.Vertex, error)) (solver.Vertex, error) {
 		opMetadata := def.Metadata[dgst]
 		vtx, err := newVertex(dgst, pbOp, &opMetadata, load, opts...)
 		if err != nil {
 			return nil, err
 		}
+		sm.add(vtx.Digest(), dgst)
 		return vtx, nil
 	})
+	return e, sm, err
 }
 
 func newVertex(dgst digest.Digest, op *pb.Op, opMeta *pb.OpMetadata, load func(digest.Digest) (solver.Vertex, error), opts ...LoadOpt) (*vertex, error) {
@@ -138,23 +192,66 @@ func newVertex(dgst digest.Digest, op *pb.Op, opMeta *pb.OpMetadata, load func(d
 		if opMeta.ExportCache != nil {
 			opt.ExportCache = &opMeta.ExportCache.Value
 		}
+		if opMeta.Timeout < 0 {
+			return nil, errors.Errorf("invalid timeout %d", opMeta.Timeout)
+		}
+		opt.Timeout = time.Duration(opMeta.Timeout) * time.Second
+	}
+	var src pb.SourceOp
+	if s, ok := op.Op.(*pb.Op_Source); ok {
+		src = *s.Source
 	}
 	for _, fn := range opts {
 		if err := fn(op, opMeta, &opt); err != nil {
 			return nil, err
 		}
 	}
+	if err := validateInputs(op); err != nil {
+		return nil, err
+	}
+	// ops changed by a source policy, and the ops depending on them, get a new
+	// digest so that they are not shared with builds using other policies
+	var mutated bool
+	if s, ok := op.Op.(*pb.Op_Source); ok {
+		mutated = s.Source.Identifier != src.Identifier || !reflect.DeepEqual(s.Source.Attrs, src.Attrs)
+	}
 	vtx := &vertex{sys: op, options: opt, digest: dgst, name: llbOpName(op)}
-	for _, in := range op.Inputs {
+	for i, in := range op.Inputs {
 		sub, err := load(in.Digest)
 		if err != nil {
 			return nil, err
 		}
+		if sub.Digest() != in.Digest {
+			op.Inputs[i].Digest = sub.Digest()
+			mutated = true
+		}
 		vtx.inputs = append(vtx.inputs, solver.Edge{Index: solver.Index(in.Index), Vertex: sub})
 	}
+	if mutated {
+		dt, err := op.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		vtx.digest = digest.FromBytes(dt)
+	}
 	return vtx, nil
 }
 
+// validateInputs checks that the inputs referenced by an op exist
+func validateInputs(op *pb.Op) error {
+	if m, ok := op.Op.(*pb.Op_Merge); ok {
+		if len(m.Merge.Inputs) == 0 {
+			return errors.Errorf("merge requires at least one input")
+		}
+		for _, inp := range m.Merge.Inputs {
+			if inp.Input < 0 || int(inp.Input) >= len(op.Inputs) {
+				return errors.Errorf("invalid input %d for merge", inp.Input)
+			}
+		}
+	}
+	return nil
+}
+
 // loadLLB loads LLB.
 // fn is executed sequentially.
 func loadLLB(def *pb.Definition, fn func(digest.Digest, *pb.Op, func(digest.Digest) (solver.Vertex, error)) (solver.Vertex, error)) (solver.Edge, error) {
@@ -214,9 +311,32 @@ func llbOpName(op *pb.Op) string {
 		return op.Source.Identifier
 	case *pb.Op_Exec:
 		return strings.Join(op.Exec.Meta.Args, " ")
+	case *pb.Op_File:
+		return fileOpName(op.File.Actions)
+	case *pb.Op_Merge:
+		return "merge"
+	case *pb.Op_Diff:
+		return "diff"
 	case *pb.Op_Build:
 		return "build"
 	default:
 		return "unknown"
 	}
 }
+
+func fileOpName(actions []*pb.FileAction) string {
+	names := make([]string, 0, len(actions))
+	for _, action := range actions {
+		switch a := action.Action.(type) {
+		case *pb.FileAction_Mkdir:
+			names = append(names, "mkdir "+a.Mkdir.Path)
+		case *pb.FileAction_Mkfile:
+			names = append(names, "mkfile "+a.Mkfile.Path)
+		case *pb.FileAction_Rm:
+			names = append(names, "rm "+a.Rm.Path)
+		case *pb.FileAction_Copy:
+			names = append(names, "copy "+a.Copy.Src+" "+a.Copy.Dest)
+		}
+	}
+	return strings.Join(names, ", ")
+}
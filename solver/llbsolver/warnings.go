@@ -0,0 +1,63 @@
+package llbsolver
+
+import (
+	"context"
+	"sync"
+
+	"github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/frontend"
+	gw "github.com/moby/buildkit/frontend/gateway/client"
+	"github.com/moby/buildkit/identity"
+	"github.com/moby/buildkit/util/progress"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+const keyWarnings = "llb.warnings"
+
+// warningRecorder collects the warnings of a job for the solve response
+type warningRecorder struct {
+	mu       sync.Mutex
+	warnings []*client.VertexWarning
+}
+
+func (r *warningRecorder) add(w *client.VertexWarning) {
+	r.mu.Lock()
+	r.warnings = append(r.warnings, w)
+	r.mu.Unlock()
+}
+
+func (r *warningRecorder) all() []*client.VertexWarning {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*client.VertexWarning{}, r.warnings...)
+}
+
+func (b *llbBridge) Warn(ctx context.Context, dgst digest.Digest, msg string, opts frontend.WarnOpts) error {
+	level := opts.Level
+	if level == 0 {
+		level = gw.WarnLevelWarning
+	}
+	w := client.VertexWarning{
+		Vertex:     dgst,
+		Level:      level,
+		Short:      []byte(msg),
+		Detail:     opts.Detail,
+		SourceInfo: opts.SourceInfo,
+		Range:      opts.Range,
+	}
+
+	pw, _, _ := progress.FromContext(b.builder.Context(ctx))
+	pw.Write(identity.NewID(), w)
+	pw.Close()
+
+	return b.builder.EachValue(ctx, keyWarnings, func(v interface{}) error {
+		r, ok := v.(*warningRecorder)
+		if !ok {
+			return errors.Errorf("invalid warning recorder %T", v)
+		}
+		wcopy := w
+		r.add(&wcopy)
+		return nil
+	})
+}
@@ -0,0 +1,4 @@
+package pb
+
+// IsFileAction is the oneof type implemented by FileAction actions
+type IsFileAction = isFileAction_Action
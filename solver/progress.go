@@ -61,6 +61,9 @@ func (j *Job) Status(ctx context.Context, ch chan *client.SolveStatus) error {
 				v.Vertex = vtx.(digest.Digest)
 				v.Timestamp = p.Timestamp
 				ss.Logs = append(ss.Logs, &v)
+			case client.VertexWarning:
+				v.Timestamp = p.Timestamp
+				ss.Warnings = append(ss.Warnings, &v)
 			}
 		}
 		select {
@@ -7,6 +7,7 @@ import (
 	"math"
 	"math/rand"
 	"os"
+	"sort"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -556,6 +557,353 @@ func TestSingleCancelExec(t *testing.T) {
 	j1 = nil
 }
 
+func TestExecTimeout(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	s := NewSolver(SolverOpt{
+		ResolveOpFunc: testOpResolver,
+	})
+	defer s.Close()
+
+	j1, err := s.NewJob("job1")
+	require.NoError(t, err)
+
+	defer func() {
+		if j1 != nil {
+			j1.Discard()
+		}
+	}()
+
+	g1 := Edge{
+		Vertex: vtx(vtxOpt{
+			name:      "v1",
+			execDelay: 10 * time.Second,
+			timeout:   50 * time.Millisecond,
+		}),
+	}
+	g1.Vertex.(*vertex).setupCallCounters()
+
+	_, err = j1.Build(ctx, g1)
+	require.Error(t, err)
+	terr, ok := errors.Cause(err).(*TimeoutError)
+	require.True(t, ok)
+	require.Equal(t, 50*time.Millisecond, terr.Timeout)
+
+	require.Equal(t, *g1.Vertex.(*vertex).execCallCount, int64(1))
+
+	require.NoError(t, j1.Discard())
+	j1 = nil
+}
+
+func TestVertexError(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	s := NewSolver(SolverOpt{
+		ResolveOpFunc: testOpResolver,
+	})
+	defer s.Close()
+
+	j1, err := s.NewJob("job1")
+	require.NoError(t, err)
+
+	defer func() {
+		if j1 != nil {
+			j1.Discard()
+		}
+	}()
+
+	errFailed := errors.New("failed")
+	failing := vtx(vtxOpt{
+		name: "v1",
+		execPreFunc: func(context.Context) error {
+			return errFailed
+		},
+	})
+	g1 := Edge{
+		Vertex: vtx(vtxOpt{
+			name:   "v2",
+			inputs: []Edge{{Vertex: failing}},
+		}),
+	}
+
+	_, err = j1.Build(ctx, g1)
+	require.Error(t, err)
+	require.Equal(t, errFailed, errors.Cause(err))
+
+	var verr *VertexError
+	for e := err; e != nil; {
+		if v, ok := e.(*VertexError); ok {
+			verr = v
+			break
+		}
+		c, ok := e.(interface {
+			Cause() error
+		})
+		if !ok {
+			break
+		}
+		e = c.Cause()
+	}
+	require.NotNil(t, verr)
+	require.Equal(t, failing.Digest(), verr.Digest)
+
+	require.NoError(t, j1.Discard())
+	j1 = nil
+}
+
+func TestCacheExplanations(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	s := NewSolver(SolverOpt{
+		ResolveOpFunc: testOpResolver,
+	})
+	defer s.Close()
+
+	graph := func(seed1 string) Edge {
+		return Edge{
+			Vertex: vtx(vtxOpt{
+				name:         "v2",
+				cacheKeySeed: "seed2",
+				value:        "result2",
+				inputs: []Edge{
+					{Vertex: vtx(vtxOpt{
+						name:         "v0",
+						cacheKeySeed: "seed0",
+						value:        "result0",
+					})},
+					{Vertex: vtx(vtxOpt{
+						name:         "v1",
+						cacheKeySeed: seed1,
+						value:        "result1",
+					})},
+				},
+			}),
+		}
+	}
+
+	j0, err := s.NewJob("job0")
+	require.NoError(t, err)
+
+	defer func() {
+		if j0 != nil {
+			j0.Discard()
+		}
+	}()
+
+	_, err = j0.Build(ctx, graph("seed1"))
+	require.NoError(t, err)
+
+	exps := j0.CacheExplanations()
+	require.Equal(t, 3, len(exps))
+	for _, ex := range exps {
+		require.False(t, ex.Cached)
+	}
+	require.Equal(t, "v2", exps[2].Name)
+	require.Equal(t, "no previous record for operation with any of its inputs", exps[2].Reason)
+
+	require.NoError(t, j0.Discard())
+	j0 = nil
+
+	j1, err := s.NewJob("job1")
+	require.NoError(t, err)
+
+	defer func() {
+		if j1 != nil {
+			j1.Discard()
+		}
+	}()
+
+	_, err = j1.Build(ctx, graph("seed1-changed"))
+	require.NoError(t, err)
+
+	exps = j1.CacheExplanations()
+	require.Equal(t, 3, len(exps))
+
+	require.Equal(t, "v0", exps[0].Name)
+	require.True(t, exps[0].Cached)
+
+	require.Equal(t, "v1", exps[1].Name)
+	require.False(t, exps[1].Cached)
+	require.Equal(t, "no previous record for operation", exps[1].Reason)
+
+	require.Equal(t, "v2", exps[2].Name)
+	require.False(t, exps[2].Cached)
+	require.Equal(t, "input 1 changed", exps[2].Reason)
+	require.Equal(t, 2, len(exps[2].Inputs))
+	require.False(t, exps[2].Inputs[0].Changed)
+	require.True(t, exps[2].Inputs[1].Changed)
+	require.Equal(t, 1, len(exps[2].Inputs[0].Keys))
+
+	require.NoError(t, j1.Discard())
+	j1 = nil
+}
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	s := NewSolver(SolverOpt{
+		ResolveOpFunc: testOpResolver,
+	})
+	defer s.Close()
+
+	graph := func(seed1 string) Edge {
+		g := Edge{
+			Vertex: vtx(vtxOpt{
+				name:         "v2",
+				cacheKeySeed: "seed2",
+				value:        "result2",
+				inputs: []Edge{
+					{Vertex: vtx(vtxOpt{
+						name:         "v0",
+						cacheKeySeed: "seed0",
+						value:        "result0",
+					})},
+					{Vertex: vtx(vtxOpt{
+						name:         "v1",
+						cacheKeySeed: seed1,
+						value:        "result1",
+					})},
+				},
+				slowCacheCompute: map[int]ResultBasedCacheFunc{
+					1: digestFromResult,
+				},
+			}),
+		}
+		g.Vertex.(*vertex).setupCallCounters()
+		return g
+	}
+
+	j0, err := s.NewJob("job0")
+	require.NoError(t, err)
+
+	defer func() {
+		if j0 != nil {
+			j0.Discard()
+		}
+	}()
+
+	_, err = j0.Build(ctx, graph("seed1"))
+	require.NoError(t, err)
+
+	require.NoError(t, j0.Discard())
+	j0 = nil
+
+	j1, err := s.NewJob("job1")
+	require.NoError(t, err)
+
+	defer func() {
+		if j1 != nil {
+			j1.Discard()
+		}
+	}()
+
+	size := func(ctx context.Context, res Result) (int64, error) {
+		return int64(len(unwrap(res))), nil
+	}
+
+	g1 := graph("seed1-changed")
+	vtxs, err := j1.DryRun(ctx, g1, DryRunOpt{Size: size})
+	require.NoError(t, err)
+	require.Equal(t, *g1.Vertex.(*vertex).execCallCount, int64(0))
+
+	require.Equal(t, 3, len(vtxs))
+	require.Equal(t, "v0", vtxs[0].Name)
+	require.True(t, vtxs[0].Cached)
+	require.Equal(t, "v1", vtxs[1].Name)
+	require.False(t, vtxs[1].Cached)
+	require.False(t, vtxs[1].Executed)
+	require.Equal(t, "v2", vtxs[2].Name)
+	require.False(t, vtxs[2].Cached)
+	require.Equal(t, int64(len("result0")), vtxs[2].InputSize)
+
+	require.NoError(t, j1.Discard())
+	j1 = nil
+
+	j2, err := s.NewJob("job2")
+	require.NoError(t, err)
+
+	defer func() {
+		if j2 != nil {
+			j2.Discard()
+		}
+	}()
+
+	// executing v1 allows matching v2 by the content of its result
+	g2 := graph("seed1-changed")
+	vtxs, err = j2.DryRun(ctx, g2, DryRunOpt{
+		Exec: func(v Vertex) bool {
+			return v.Name() == "v1"
+		},
+		Size: size,
+	})
+	require.NoError(t, err)
+	require.Equal(t, *g2.Vertex.(*vertex).execCallCount, int64(1))
+
+	require.Equal(t, 3, len(vtxs))
+	require.True(t, vtxs[0].Cached)
+	require.False(t, vtxs[1].Cached)
+	require.True(t, vtxs[1].Executed)
+	require.True(t, vtxs[2].Cached)
+	require.Equal(t, int64(len("result0")+len("result1")), vtxs[2].InputSize)
+
+	require.NoError(t, j2.Discard())
+	j2 = nil
+}
+
+func TestJobEachOp(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	s := NewSolver(SolverOpt{
+		ResolveOpFunc: testOpResolver,
+	})
+	defer s.Close()
+
+	j0, err := s.NewJob("job0")
+	require.NoError(t, err)
+
+	defer func() {
+		if j0 != nil {
+			j0.Discard()
+		}
+	}()
+
+	g0 := Edge{
+		Vertex: vtx(vtxOpt{
+			name:         "v0",
+			cacheKeySeed: "seed0",
+			value:        "result0",
+			inputs: []Edge{
+				{Vertex: vtx(vtxOpt{
+					name:         "v1",
+					cacheKeySeed: "seed1",
+					value:        "result1",
+				})},
+			},
+		}),
+	}
+
+	_, err = j0.Build(ctx, g0)
+	require.NoError(t, err)
+
+	var names []string
+	err = j0.EachOp(func(v Vertex, op Op) error {
+		require.NotNil(t, op)
+		names = append(names, v.Name())
+		return nil
+	})
+	require.NoError(t, err)
+	sort.Strings(names)
+	require.Equal(t, []string{"v0", "v1"}, names)
+
+	require.NoError(t, j0.Discard())
+	j0 = nil
+}
+
 func TestSingleCancelParallel(t *testing.T) {
 	t.Parallel()
 	ctx := context.TODO()
@@ -1116,6 +1464,68 @@ func TestSlowCache(t *testing.T) {
 
 }
 
+// TestSlowCacheExecutedOnly validates that an input that is rebuilt with the
+// same result gives a cache hit, and that its result is not slow cached when
+// it is loaded from the cache
+func TestSlowCacheExecutedOnly(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	l := NewSolver(SolverOpt{
+		ResolveOpFunc: testOpResolver,
+	})
+	defer l.Close()
+
+	var slowCalls int64
+	slowCache := map[int]ResultBasedCacheFunc{
+		0: func(ctx context.Context, res Result) (digest.Digest, error) {
+			atomic.AddInt64(&slowCalls, 1)
+			return digestFromResult(ctx, res)
+		},
+	}
+	build := func(seed, inputSeed, value string) (string, int64) {
+		j, err := l.NewJob(identity.NewID())
+		require.NoError(t, err)
+		defer j.Discard()
+
+		var execCount int64
+		v := vtx(vtxOpt{
+			cacheKeySeed: seed,
+			value:        value,
+			inputs: []Edge{
+				{Vertex: vtx(vtxOpt{
+					cacheKeySeed: inputSeed,
+					value:        "result1",
+				})},
+			},
+			slowCacheCompute:      slowCache,
+			slowCacheExecutedOnly: map[int]bool{0: true},
+		})
+		v.execCallCount = &execCount
+
+		res, err := j.Build(ctx, Edge{Vertex: v})
+		require.NoError(t, err)
+		return unwrap(res), execCount
+	}
+
+	res, execCount := build("seed0", "seed1", "result0")
+	require.Equal(t, "result0", res)
+	require.Equal(t, int64(1), execCount)
+	require.Equal(t, int64(1), atomic.LoadInt64(&slowCalls))
+
+	// the input is rebuilt with the same result
+	res, execCount = build("seed0", "seed2", "not-cached")
+	require.Equal(t, "result0", res)
+	require.Equal(t, int64(0), execCount)
+	require.Equal(t, int64(2), atomic.LoadInt64(&slowCalls))
+
+	// the input is loaded from the cache and not slow cached
+	res, execCount = build("seed3", "seed2", "result3")
+	require.Equal(t, "result3", res)
+	require.Equal(t, int64(1), execCount)
+	require.Equal(t, int64(2), atomic.LoadInt64(&slowCalls))
+}
+
 // TestParallelInputs validates that inputs are processed in parallel
 func TestParallelInputs(t *testing.T) {
 	t.Parallel()
@@ -3117,9 +3527,13 @@ type vtxOpt struct {
 	inputs           []Edge
 	value            string
 	slowCacheCompute map[int]ResultBasedCacheFunc
-	selectors        map[int]digest.Digest
-	cacheSource      CacheManager
-	ignoreCache      bool
+	// slowCacheExecutedOnly marks the inputs that are only slow cached
+	// when they were executed
+	slowCacheExecutedOnly map[int]bool
+	selectors             map[int]digest.Digest
+	cacheSource           CacheManager
+	ignoreCache           bool
+	timeout               time.Duration
 }
 
 func vtx(opt vtxOpt) *vertex {
@@ -3159,6 +3573,7 @@ func (v *vertex) Options() VertexOptions {
 	return VertexOptions{
 		CacheSources: cache,
 		IgnoreCache:  v.opt.ignoreCache,
+		Timeout:      v.opt.timeout,
 	}
 }
 
@@ -3259,12 +3674,14 @@ func (v *vertex) makeCacheMap() *CacheMap {
 	m := &CacheMap{
 		Digest: digest.FromBytes([]byte(fmt.Sprintf("seed:%s", v.opt.cacheKeySeed))),
 		Deps: make([]struct {
-			Selector          digest.Digest
-			ComputeDigestFunc ResultBasedCacheFunc
+			Selector                  digest.Digest
+			ComputeDigestFunc         ResultBasedCacheFunc
+			ComputeDigestExecutedOnly bool
 		}, len(v.Inputs())),
 	}
 	for i, f := range v.opt.slowCacheCompute {
 		m.Deps[i].ComputeDigestFunc = f
+		m.Deps[i].ComputeDigestExecutedOnly = v.opt.slowCacheExecutedOnly[i]
 	}
 	for i, dgst := range v.opt.selectors {
 		m.Deps[i].Selector = dgst
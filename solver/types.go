@@ -2,6 +2,7 @@ package solver
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/containerd/containerd/content"
@@ -37,9 +38,40 @@ type VertexOptions struct {
 	CacheSources []CacheManager
 	Description  map[string]string // text values with no special meaning for solver
 	ExportCache  *bool
+	Timeout      time.Duration // maximum duration of Exec, 0 for no limit
 	// WorkerConstraint
 }
 
+// TimeoutError is returned when a vertex does not finish within its timeout
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s", e.Timeout)
+}
+
+// VertexError is returned when a vertex fails. Digest is the digest of the
+// vertex as it was loaded, before any changes for cache options.
+type VertexError struct {
+	Digest digest.Digest
+	error
+}
+
+func (e *VertexError) Cause() error {
+	return e.error
+}
+
+func wrapVertexError(err error, dgst digest.Digest) error {
+	if err == nil {
+		return nil
+	}
+	if ve, ok := err.(*VertexError); ok && ve.Digest == dgst {
+		return err
+	}
+	return &VertexError{Digest: dgst, error: err}
+}
+
 // Result is an abstract return value for a solve
 type Result interface {
 	ID() string
@@ -131,6 +163,9 @@ type CacheMap struct {
 		// Optional function that returns a digest for the input based on its
 		// return value
 		ComputeDigestFunc ResultBasedCacheFunc
+		// ComputeDigestExecutedOnly skips ComputeDigestFunc when the result of
+		// the input was loaded from the cache instead of executed
+		ComputeDigestExecutedOnly bool
 	}
 }
 
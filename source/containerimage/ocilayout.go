@@ -0,0 +1,94 @@
+package containerimage
+
+import (
+	"context"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/reference"
+	"github.com/moby/buildkit/session"
+	sessioncontent "github.com/moby/buildkit/session/content"
+	"github.com/moby/buildkit/source"
+	"github.com/moby/buildkit/util/contentutil"
+	"github.com/moby/buildkit/util/pull"
+	"github.com/pkg/errors"
+)
+
+type ociLayoutSource struct {
+	SourceOpt
+}
+
+// NewOCILayoutSource returns a source for images in OCI layout directories.
+// The stores in opt.OCIStores are used first, other stores are read from the
+// session of the build.
+func NewOCILayoutSource(opt SourceOpt) (source.Source, error) {
+	return &ociLayoutSource{SourceOpt: opt}, nil
+}
+
+func (ls *ociLayoutSource) ID() string {
+	return source.OCILayoutScheme
+}
+
+func (ls *ociLayoutSource) Resolve(ctx context.Context, id source.Identifier) (source.SourceInstance, error) {
+	ociIdentifier, ok := id.(*source.OCIIdentifier)
+	if !ok {
+		return nil, errors.Errorf("invalid oci-layout identifier %v", id)
+	}
+
+	platform := platforms.DefaultSpec()
+	if ociIdentifier.Platform != nil {
+		platform = *ociIdentifier.Platform
+	}
+
+	store, fromSession, err := ls.store(ctx, ociIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	pullerUtil := &pull.Puller{
+		Snapshotter:  ls.Snapshotter,
+		ContentStore: ls.ContentStore,
+		Applier:      ls.Applier,
+		Src: reference.Spec{
+			Locator: ociIdentifier.StoreID,
+			Object:  "@" + ociIdentifier.Digest.String(),
+		},
+		Resolver: contentutil.ProviderResolver(store),
+		Platform: &platform,
+	}
+	p := &puller{
+		CacheAccessor: ls.CacheAccessor,
+		Puller:        pullerUtil,
+		Platform:      platform,
+		// the session is closed after the build, so lazy layers could not
+		// be fetched anymore
+		eager: fromSession,
+	}
+	return p, nil
+}
+
+// store returns the content store for the identifier and whether it is read
+// through a session
+func (ls *ociLayoutSource) store(ctx context.Context, id *source.OCIIdentifier) (content.Store, bool, error) {
+	if store, ok := ls.OCIStores[id.StoreID]; ok {
+		return store, false, nil
+	}
+
+	sessionID := id.SessionID
+	if sessionID == "" {
+		sessionID = session.FromContext(ctx)
+	}
+	if sessionID == "" || ls.SessionManager == nil {
+		return nil, false, errors.Errorf("oci-layout store %s not found", id.StoreID)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	caller, err := ls.SessionManager.Get(timeoutCtx, sessionID)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to get session for oci-layout store %s", id.StoreID)
+	}
+	return sessioncontent.NewCallerStore(caller, id.StoreID), true, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/containerd/containerd/platforms"
 	"github.com/docker/distribution/reference"
 	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/client"
 	gw "github.com/moby/buildkit/frontend/gateway/client"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/snapshot"
@@ -37,6 +38,9 @@ type SourceOpt struct {
 	CacheAccessor  cache.Accessor
 	ImageStore     images.Store // optional
 	ResolverOpt    resolver.ResolveOptionsFunc
+	// OCIStores are the OCI layout stores the oci-layout source can read
+	// without a session, by store ID
+	OCIStores map[string]content.Store // optional
 }
 
 type imageSource struct {
@@ -108,7 +112,8 @@ func (is *imageSource) Resolve(ctx context.Context, id source.Identifier) (sourc
 		CacheAccessor: is.CacheAccessor,
 		Puller:        pullerUtil,
 		Platform:      platform,
-		id:            imageIdentifier,
+		recordType:    imageIdentifier.RecordType,
+		registry:      true,
 	}
 	return p, nil
 }
@@ -116,7 +121,14 @@ func (is *imageSource) Resolve(ctx context.Context, id source.Identifier) (sourc
 type puller struct {
 	CacheAccessor cache.Accessor
 	Platform      specs.Platform
-	id            *source.ImageIdentifier
+	recordType    client.UsageRecordType
+	// registry is set if the layer blobs can be mounted from the pulled
+	// reference when pushing to the same registry
+	registry bool
+	// eager fetches and unpacks all layers when the ref is created. It is
+	// needed if the provider of the blobs is gone after the build.
+	eager bool
+	pin   digest.Digest
 	*pull.Puller
 }
 
@@ -143,6 +155,7 @@ func (p *puller) CacheKey(ctx context.Context, index int) (string, bool, error)
 	if err != nil {
 		return "", false, err
 	}
+	p.pin = desc.Digest
 	if index == 0 || desc.Digest == "" {
 		k, err := mainManifestKey(ctx, desc, p.Platform)
 		if err != nil {
@@ -170,6 +183,10 @@ func (p *puller) CacheKey(ctx context.Context, index int) (string, bool, error)
 	return cacheKeyFromConfig(dt).String(), true, nil
 }
 
+func (p *puller) Pin() string {
+	return p.pin.String()
+}
+
 func (p *puller) Snapshot(ctx context.Context) (cache.ImmutableRef, error) {
 	layerNeedsTypeWindows := false
 	if platform := p.Puller.Platform; platform != nil {
@@ -179,15 +196,8 @@ func (p *puller) Snapshot(ctx context.Context) (cache.ImmutableRef, error) {
 		}
 	}
 
-	pulled, err := p.Puller.Pull(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if pulled.ChainID == "" {
-		return nil, nil
-	}
-	ref, err := p.CacheAccessor.GetFromSnapshotter(ctx, string(pulled.ChainID), cache.WithDescription("pulled from "+pulled.Ref))
-	if err != nil {
+	ref, err := p.pull(ctx)
+	if err != nil || ref == nil {
 		return nil, err
 	}
 
@@ -198,8 +208,8 @@ func (p *puller) Snapshot(ctx context.Context) (cache.ImmutableRef, error) {
 		}
 	}
 
-	if p.id.RecordType != "" && cache.GetRecordType(ref) == "" {
-		if err := cache.SetRecordType(ref, p.id.RecordType); err != nil {
+	if p.recordType != "" && cache.GetRecordType(ref) == "" {
+		if err := cache.SetRecordType(ref, p.recordType); err != nil {
 			ref.Release(context.TODO())
 			return nil, err
 		}
@@ -208,6 +218,50 @@ func (p *puller) Snapshot(ctx context.Context) (cache.ImmutableRef, error) {
 	return ref, nil
 }
 
+// pull returns a ref for the image. The layers of the image are only fetched
+// and unpacked when the ref is mounted, except for schema1 images and eager
+// pullers.
+func (p *puller) pull(ctx context.Context) (cache.ImmutableRef, error) {
+	_, desc, err := p.Puller.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.eager || desc.MediaType == images.MediaTypeDockerSchema1Manifest {
+		pulled, err := p.Puller.Pull(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if pulled.ChainID == "" {
+			return nil, nil
+		}
+		return p.CacheAccessor.GetFromSnapshotter(ctx, string(pulled.ChainID), cache.WithDescription("pulled from "+pulled.Ref))
+	}
+
+	pulled, err := p.Puller.PullManifests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dh := &cache.DescHandler{
+		Provider: pulled.Provider,
+	}
+	if p.registry {
+		dh.Ref = pulled.Ref
+	}
+	var ref cache.ImmutableRef
+	for _, layer := range pulled.Layers {
+		parent := ref
+		ref, err = p.CacheAccessor.GetByBlob(ctx, layer, parent, dh, cache.WithDescription("pulled from "+pulled.Ref))
+		if parent != nil {
+			parent.Release(context.TODO())
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ref, nil
+}
+
 func markRefLayerTypeWindows(ref cache.ImmutableRef) error {
 	if parent := ref.Parent(); parent != nil {
 		defer parent.Release(context.TODO())
@@ -0,0 +1,180 @@
+package git
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/secrets"
+	"github.com/moby/buildkit/session/sshforward"
+	"github.com/pkg/errors"
+)
+
+// gitAuth holds the environment git commands that access the remote are run
+// with. The credentials are passed as environment variables, so they are not
+// stored in the repository or in cache keys, and are not visible in the
+// arguments of the git processes. Reading configuration from the environment
+// requires git 2.31 or later.
+type gitAuth struct {
+	env []string
+}
+
+// getAuth returns the credentials the source requested from the session of
+// the build. It returns nil if the source does not need credentials.
+func (gs *gitSourceHandler) getAuth(ctx context.Context) (_ *gitAuth, _ func(), retErr error) {
+	src := gs.src
+	if src.AuthTokenSecret == "" && src.AuthHeaderSecret == "" && src.MountSSHSock == "" {
+		return nil, func() {}, nil
+	}
+
+	sessionID := session.FromContext(ctx)
+	if sessionID == "" || gs.sm == nil {
+		return nil, nil, errors.Errorf("could not access credentials for %s without session", src.Remote)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	caller, err := gs.sm.Get(timeoutCtx, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	auth := &gitAuth{
+		// fail instead of waiting for credentials on a terminal
+		env: []string{"GIT_TERMINAL_PROMPT=0"},
+	}
+	var cleanups []func()
+	cleanup := func() {
+		for _, f := range cleanups {
+			f()
+		}
+	}
+	defer func() {
+		if retErr != nil {
+			cleanup()
+		}
+	}()
+
+	if u, err := url.Parse(src.Remote); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		var header string
+		switch {
+		case src.AuthHeaderSecret != "":
+			dt, err := secrets.GetSecret(ctx, caller, src.AuthHeaderSecret)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to get auth header for %s", src.Remote)
+			}
+			header = strings.TrimSpace(string(dt))
+		case src.AuthTokenSecret != "":
+			dt, err := secrets.GetSecret(ctx, caller, src.AuthTokenSecret)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to get auth token for %s", src.Remote)
+			}
+			header = "basic " + base64.StdEncoding.EncodeToString([]byte("x-access-token:"+strings.TrimSpace(string(dt))))
+		}
+		if header != "" {
+			if err := checkGitConfigEnv(ctx); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to authenticate to %s", src.Remote)
+			}
+			// the header is only sent to the host of the remote, not to
+			// submodules on other hosts
+			scope := u.Scheme + "://" + u.Host + "/"
+			auth.env = append(auth.env,
+				"GIT_CONFIG_COUNT=1",
+				"GIT_CONFIG_KEY_0=http."+scope+".extraheader",
+				"GIT_CONFIG_VALUE_0=Authorization: "+header,
+			)
+		}
+	}
+
+	if src.MountSSHSock != "" {
+		sshCtx, cancel := context.WithCancel(context.TODO())
+		sock, closer, err := sshforward.MountSSHSocket(sshCtx, caller, sshforward.SocketOpt{
+			ID:   src.MountSSHSock,
+			UID:  os.Getuid(),
+			GID:  os.Getgid(),
+			Mode: 0600,
+		})
+		if err != nil {
+			cancel()
+			return nil, nil, errors.Wrapf(err, "failed to forward ssh agent for %s", src.Remote)
+		}
+		cleanups = append(cleanups, func() {
+			closer()
+			cancel()
+		})
+
+		sshCmd := "ssh -F /dev/null"
+		if src.KnownSSHHosts != "" {
+			dir, err := ioutil.TempDir("", "buildkit-git-known-hosts")
+			if err != nil {
+				return nil, nil, err
+			}
+			cleanups = append(cleanups, func() {
+				os.RemoveAll(dir)
+			})
+			fn := filepath.Join(dir, "known_hosts")
+			if err := ioutil.WriteFile(fn, []byte(src.KnownSSHHosts), 0600); err != nil {
+				return nil, nil, err
+			}
+			sshCmd += " -o UserKnownHostsFile=" + fn
+		} else {
+			sshCmd += " -o StrictHostKeyChecking=no"
+		}
+		auth.env = append(auth.env, "SSH_AUTH_SOCK="+sock, "GIT_SSH_COMMAND="+sshCmd)
+	}
+
+	return auth, cleanup, nil
+}
+
+// gitConfigEnvVersion is the first git version that reads configuration from
+// GIT_CONFIG_COUNT and the variables it numbers. Older versions ignore them
+// and would fetch without the credentials.
+var gitConfigEnvVersion = [2]int{2, 31}
+
+// checkGitConfigEnv returns an error if the installed git does not read
+// configuration from the environment
+func checkGitConfigEnv(ctx context.Context) error {
+	out, err := exec.CommandContext(ctx, "git", "--version").Output()
+	if err != nil {
+		return errors.Wrap(err, "failed to get git version")
+	}
+	major, minor, err := parseGitVersion(string(out))
+	if err != nil {
+		return err
+	}
+	if major < gitConfigEnvVersion[0] || major == gitConfigEnvVersion[0] && minor < gitConfigEnvVersion[1] {
+		return errors.Errorf("git %d.%d does not support credentials for HTTP remotes, git %d.%d or later is required", major, minor, gitConfigEnvVersion[0], gitConfigEnvVersion[1])
+	}
+	return nil
+}
+
+// parseGitVersion returns the major and minor version in the output of
+// git --version, for example "git version 2.39.2 (Apple Git-143)"
+func parseGitVersion(out string) (int, int, error) {
+	fields := strings.Fields(out)
+	if len(fields) < 3 || fields[0] != "git" || fields[1] != "version" {
+		return 0, 0, errors.Errorf("invalid git version %q", strings.TrimSpace(out))
+	}
+	parts := strings.SplitN(fields[2], ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, errors.Errorf("invalid git version %q", fields[2])
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid git version %q", fields[2])
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid git version %q", fields[2])
+	}
+	return major, minor, nil
+}
@@ -0,0 +1,106 @@
+package git
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/secrets/secretsprovider"
+	"github.com/moby/buildkit/session/testutil"
+	"github.com/moby/buildkit/source"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestGetAuth(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	s, err := session.NewSession(ctx, "foo", "bar")
+	require.NoError(t, err)
+
+	sm, err := session.NewManager()
+	require.NoError(t, err)
+
+	s.Allow(secretsprovider.FromMap(map[string][]byte{
+		"token":  []byte("abc\n"),
+		"header": []byte("bearer def"),
+	}))
+
+	dialer := session.Dialer(testutil.TestStream(testutil.Handler(sm.HandleConn)))
+
+	g, ctx := errgroup.WithContext(context.Background())
+
+	g.Go(func() error {
+		return s.Run(ctx, dialer)
+	})
+
+	g.Go(func() error {
+		defer s.Close()
+		ctx := session.NewContext(ctx, s.ID())
+
+		getAuth := func(src source.GitIdentifier) (*gitAuth, error) {
+			gs := &gitSourceHandler{gitSource: &gitSource{sm: sm}, src: src}
+			auth, cleanup, err := gs.getAuth(ctx)
+			if err != nil {
+				return nil, err
+			}
+			cleanup()
+			return auth, nil
+		}
+
+		auth, err := getAuth(source.GitIdentifier{Remote: "https://example.com/foo/bar.git"})
+		require.NoError(t, err)
+		require.Nil(t, auth)
+
+		auth, err = getAuth(source.GitIdentifier{Remote: "https://example.com/foo/bar.git", AuthTokenSecret: "token"})
+		require.NoError(t, err)
+		header := "basic " + base64.StdEncoding.EncodeToString([]byte("x-access-token:abc"))
+		require.Equal(t, []string{"GIT_TERMINAL_PROMPT=0", "GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0=http.https://example.com/.extraheader", "GIT_CONFIG_VALUE_0=Authorization: " + header}, auth.env)
+
+		auth, err = getAuth(source.GitIdentifier{Remote: "https://example.com/foo/bar.git", AuthTokenSecret: "token", AuthHeaderSecret: "header"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"GIT_TERMINAL_PROMPT=0", "GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0=http.https://example.com/.extraheader", "GIT_CONFIG_VALUE_0=Authorization: bearer def"}, auth.env)
+
+		// credentials for HTTP remotes are not sent to SSH remotes
+		auth, err = getAuth(source.GitIdentifier{Remote: "git@example.com:foo/bar.git", AuthTokenSecret: "token"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"GIT_TERMINAL_PROMPT=0"}, auth.env)
+
+		_, err = getAuth(source.GitIdentifier{Remote: "https://example.com/foo/bar.git", AuthTokenSecret: "missing"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not found")
+		return nil
+	})
+
+	require.NoError(t, g.Wait())
+}
+
+func TestParseGitVersion(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		out          string
+		major, minor int
+		valid        bool
+	}{
+		{"git version 2.39.5\n", 2, 39, true},
+		{"git version 2.30.1", 2, 30, true},
+		{"git version 2.39.2 (Apple Git-143)\n", 2, 39, true},
+		{"git version 2.20.1.windows.1", 2, 20, true},
+		{"git version 3.0", 3, 0, true},
+		{"git version 2", 0, 0, false},
+		{"version 2.31.0", 0, 0, false},
+		{"git version a.b.c", 0, 0, false},
+	} {
+		major, minor, err := parseGitVersion(tc.out)
+		if !tc.valid {
+			require.Error(t, err, tc.out)
+			continue
+		}
+		require.NoError(t, err, tc.out)
+		require.Equal(t, tc.major, major, tc.out)
+		require.Equal(t, tc.minor, minor, tc.out)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -16,9 +17,11 @@ import (
 	"github.com/moby/buildkit/cache/metadata"
 	"github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/identity"
+	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/snapshot"
 	"github.com/moby/buildkit/source"
 	"github.com/moby/buildkit/util/progress/logs"
+	"github.com/moby/buildkit/util/retry"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	bolt "go.etcd.io/bbolt"
@@ -27,13 +30,15 @@ import (
 var validHex = regexp.MustCompile(`^[a-f0-9]{40}$`)
 
 type Opt struct {
-	CacheAccessor cache.Accessor
-	MetadataStore *metadata.Store
+	CacheAccessor  cache.Accessor
+	MetadataStore  *metadata.Store
+	SessionManager *session.Manager // optional, used for credentials
 }
 
 type gitSource struct {
 	md     *metadata.Store
 	cache  cache.Accessor
+	sm     *session.Manager
 	locker *locker.Locker
 }
 
@@ -41,6 +46,7 @@ func NewSource(opt Opt) (source.Source, error) {
 	gs := &gitSource{
 		md:     opt.MetadataStore,
 		cache:  opt.CacheAccessor,
+		sm:     opt.SessionManager,
 		locker: locker.New(),
 	}
 
@@ -115,11 +121,11 @@ func (gs *gitSource) mountRemote(ctx context.Context, remote string) (target str
 	}()
 
 	if initializeRepo {
-		if _, err := gitWithinDir(ctx, dir, "", "init", "--bare"); err != nil {
+		if _, err := gitWithinDir(ctx, nil, dir, "", "init", "--bare"); err != nil {
 			return "", nil, errors.Wrapf(err, "failed to init repo at %s", dir)
 		}
 
-		if _, err := gitWithinDir(ctx, dir, "", "remote", "add", "origin", remote); err != nil {
+		if _, err := gitWithinDir(ctx, nil, dir, "", "remote", "add", "origin", remote); err != nil {
 			return "", nil, errors.Wrapf(err, "failed add origin repo at %s", dir)
 		}
 
@@ -146,6 +152,7 @@ func (gs *gitSource) mountRemote(ctx context.Context, remote string) (target str
 type gitSourceHandler struct {
 	*gitSource
 	src      source.GitIdentifier
+	sha      string
 	cacheKey string
 }
 
@@ -171,8 +178,9 @@ func (gs *gitSourceHandler) CacheKey(ctx context.Context, index int) (string, bo
 	defer gs.locker.Unlock(remote)
 
 	if isCommitSHA(ref) {
-		gs.cacheKey = ref
-		return ref, true, nil
+		gs.sha = ref
+		gs.cacheKey = gs.shaToCacheKey(ref)
+		return gs.cacheKey, true, nil
 	}
 
 	gitDir, unmountGitDir, err := gs.mountRemote(ctx, remote)
@@ -183,7 +191,18 @@ func (gs *gitSourceHandler) CacheKey(ctx context.Context, index int) (string, bo
 
 	// TODO: should we assume that remote tag is immutable? add a timer?
 
-	buf, err := gitWithinDir(ctx, gitDir, "", "ls-remote", "origin", ref)
+	auth, cleanup, err := gs.getAuth(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	defer cleanup()
+
+	var buf *bytes.Buffer
+	err = retry.Do(ctx, "git ls-remote "+remote, func() error {
+		var err error
+		buf, err = gitWithinDir(ctx, auth, gitDir, "", "ls-remote", "origin", ref)
+		return err
+	})
 	if err != nil {
 		return "", false, errors.Wrapf(err, "failed to fetch remote %s", remote)
 	}
@@ -197,8 +216,27 @@ func (gs *gitSourceHandler) CacheKey(ctx context.Context, index int) (string, bo
 	if !isCommitSHA(sha) {
 		return "", false, errors.Errorf("invalid commit sha %q", sha)
 	}
-	gs.cacheKey = sha
-	return sha, true, nil
+	gs.sha = sha
+	gs.cacheKey = gs.shaToCacheKey(sha)
+	return gs.cacheKey, true, nil
+}
+
+// shaToCacheKey returns the cache key of the checkout of commit sha. The
+// checkouts of different subdirectories, and checkouts with and without the
+// .git directory, don't share a cache key.
+func (gs *gitSourceHandler) shaToCacheKey(sha string) string {
+	key := sha
+	if gs.src.KeepGitDir {
+		key += ".git"
+	}
+	if gs.src.Subdir != "" {
+		key += ":" + gs.src.Subdir
+	}
+	return key
+}
+
+func (gs *gitSourceHandler) Pin() string {
+	return gs.sha
 }
 
 func (gs *gitSourceHandler) Snapshot(ctx context.Context) (out cache.ImmutableRef, retErr error) {
@@ -216,7 +254,7 @@ func (gs *gitSourceHandler) Snapshot(ctx context.Context) (out cache.ImmutableRe
 		}
 	}
 
-	snapshotKey := "git-snapshot::" + cacheKey + ":" + gs.src.Subdir
+	snapshotKey := "git-snapshot::" + cacheKey
 	gs.locker.Lock(snapshotKey)
 	defer gs.locker.Unlock(snapshotKey)
 
@@ -236,10 +274,16 @@ func (gs *gitSourceHandler) Snapshot(ctx context.Context) (out cache.ImmutableRe
 	}
 	defer unmountGitDir()
 
+	auth, cleanup, err := gs.getAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
 	doFetch := true
 	if isCommitSHA(ref) {
 		// skip fetch if commit already exists
-		if _, err := gitWithinDir(ctx, gitDir, "", "cat-file", "-e", ref+"^{commit}"); err == nil {
+		if _, err := gitWithinDir(ctx, nil, gitDir, "", "cat-file", "-e", ref+"^{commit}"); err == nil {
 			doFetch = false
 		}
 	}
@@ -259,12 +303,15 @@ func (gs *gitSourceHandler) Snapshot(ctx context.Context) (out cache.ImmutableRe
 			// local refs are needed so they would be advertised on next fetches
 			// TODO: is there a better way to do this?
 		}
-		if _, err := gitWithinDir(ctx, gitDir, "", args...); err != nil {
+		if err := retry.Do(ctx, "git fetch "+gs.src.Remote, func() error {
+			_, err := gitWithinDir(ctx, auth, gitDir, "", args...)
+			return err
+		}); err != nil {
 			return nil, errors.Wrapf(err, "failed to fetch remote %s", gs.src.Remote)
 		}
 	}
 
-	checkoutRef, err := gs.cache.New(ctx, nil, cache.WithRecordType(client.UsageRecordTypeGitCheckout), cache.WithDescription(fmt.Sprintf("git snapshot for %s#%s", gs.src.Remote, ref)))
+	checkoutRef, err := gs.cache.New(ctx, nil, cache.WithRecordType(client.UsageRecordTypeGitCheckout), cache.WithDescription(fmt.Sprintf("git snapshot for %s#%s", gs.src.Remote, refAndSubdir(ref, gs.src.Subdir))))
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create new mutable for %s", gs.src.Remote)
 	}
@@ -290,42 +337,50 @@ func (gs *gitSourceHandler) Snapshot(ctx context.Context) (out cache.ImmutableRe
 		}
 	}()
 
-	if gs.src.KeepGitDir {
-		_, err = gitWithinDir(ctx, checkoutDir, "", "init")
+	if gs.src.Subdir != "" {
+		// the .git directory is not kept as the subdir is not the root of
+		// the repository
+		if err := checkoutSubdir(ctx, auth, gitDir, checkoutDir, ref, gs.src.Subdir); err != nil {
+			return nil, errors.Wrapf(err, "failed to checkout remote %s", gs.src.Remote)
+		}
+	} else if gs.src.KeepGitDir {
+		_, err = gitWithinDir(ctx, nil, checkoutDir, "", "init")
 		if err != nil {
 			return nil, err
 		}
-		_, err = gitWithinDir(ctx, checkoutDir, "", "remote", "add", "origin", gitDir)
+		_, err = gitWithinDir(ctx, nil, checkoutDir, "", "remote", "add", "origin", gitDir)
 		if err != nil {
 			return nil, err
 		}
 		pullref := ref
 		if isCommitSHA(ref) {
 			pullref = "refs/buildkit/" + identity.NewID()
-			_, err = gitWithinDir(ctx, gitDir, "", "update-ref", pullref, ref)
+			_, err = gitWithinDir(ctx, nil, gitDir, "", "update-ref", pullref, ref)
 			if err != nil {
 				return nil, err
 			}
 		}
-		_, err = gitWithinDir(ctx, checkoutDir, "", "fetch", "--depth=1", "origin", pullref)
+		_, err = gitWithinDir(ctx, nil, checkoutDir, "", "fetch", "--depth=1", "origin", pullref)
 		if err != nil {
 			return nil, err
 		}
-		_, err = gitWithinDir(ctx, checkoutDir, checkoutDir, "checkout", "FETCH_HEAD")
+		_, err = gitWithinDir(ctx, nil, checkoutDir, checkoutDir, "checkout", "FETCH_HEAD")
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to checkout remote %s", gs.src.Remote)
 		}
 		gitDir = checkoutDir
 	} else {
-		_, err = gitWithinDir(ctx, gitDir, checkoutDir, "checkout", ref, "--", ".")
+		_, err = gitWithinDir(ctx, nil, gitDir, checkoutDir, "checkout", ref, "--", ".")
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to checkout remote %s", gs.src.Remote)
 		}
 	}
 
-	_, err = gitWithinDir(ctx, gitDir, checkoutDir, "submodule", "update", "--init", "--recursive", "--depth=1")
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to update submodules for %s", gs.src.Remote)
+	if gs.src.Subdir == "" {
+		_, err = gitWithinDir(ctx, auth, gitDir, checkoutDir, "submodule", "update", "--init", "--recursive", "--depth=1")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to update submodules for %s", gs.src.Remote)
+		}
 	}
 
 	lm.Unmount()
@@ -358,25 +413,80 @@ func (gs *gitSourceHandler) Snapshot(ctx context.Context) (out cache.ImmutableRe
 	return snap, nil
 }
 
+// checkoutSubdir checks out only subdir of ref from the bare repository at
+// gitDir and moves its contents to the root of checkoutDir. Submodules are
+// only updated if they are in subdir.
+func checkoutSubdir(ctx context.Context, auth *gitAuth, gitDir, checkoutDir, ref, subdir string) error {
+	workDir, err := ioutil.TempDir(checkoutDir, ".git-checkout-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workDir)
+
+	paths := []string{subdir}
+	if _, err := gitWithinDir(ctx, nil, gitDir, "", "cat-file", "-e", ref+":.gitmodules"); err == nil {
+		paths = append(paths, ".gitmodules")
+	}
+	if _, err := gitWithinDir(ctx, nil, gitDir, workDir, append([]string{"checkout", ref, "--"}, paths...)...); err != nil {
+		return errors.Wrapf(err, "failed to checkout subdir %s", subdir)
+	}
+
+	src := filepath.Join(workDir, filepath.FromSlash(subdir))
+	fi, err := os.Lstat(src)
+	if err != nil {
+		return errors.Wrapf(err, "subdir %s not found", subdir)
+	}
+	if !fi.IsDir() {
+		return errors.Errorf("subdir %s is not a directory", subdir)
+	}
+
+	if len(paths) > 1 {
+		if _, err := gitWithinDir(ctx, auth, gitDir, workDir, "submodule", "update", "--init", "--recursive", "--depth=1", "--", subdir); err != nil {
+			return errors.Wrapf(err, "failed to update submodules in %s", subdir)
+		}
+	}
+
+	fis, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		if err := os.Rename(filepath.Join(src, fi.Name()), filepath.Join(checkoutDir, fi.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func refAndSubdir(ref, subdir string) string {
+	if subdir == "" {
+		return ref
+	}
+	return ref + ":" + subdir
+}
+
 func isCommitSHA(str string) bool {
 	return validHex.MatchString(str)
 }
 
-func gitWithinDir(ctx context.Context, gitDir, workDir string, args ...string) (*bytes.Buffer, error) {
+func gitWithinDir(ctx context.Context, auth *gitAuth, gitDir, workDir string, args ...string) (*bytes.Buffer, error) {
 	a := []string{"--git-dir", gitDir}
 	if workDir != "" {
 		a = append(a, "--work-tree", workDir)
 	}
-	return git(ctx, workDir, append(a, args...)...)
+	return git(ctx, auth, workDir, append(a, args...)...)
 }
 
-func git(ctx context.Context, dir string, args ...string) (*bytes.Buffer, error) {
+func git(ctx context.Context, auth *gitAuth, dir string, args ...string) (*bytes.Buffer, error) {
 	for {
 		stdout, stderr := logs.NewLogStreams(ctx, false)
 		defer stdout.Close()
 		defer stderr.Close()
 		cmd := exec.Command("git", args...)
 		cmd.Dir = dir // some commands like submodule require this
+		if auth != nil {
+			cmd.Env = append(os.Environ(), auth.env...)
+		}
 		buf := bytes.NewBuffer(nil)
 		errbuf := bytes.NewBuffer(nil)
 		cmd.Stdout = io.MultiWriter(stdout, buf)
@@ -391,11 +501,37 @@ func git(ctx context.Context, dir string, args ...string) (*bytes.Buffer, error)
 					continue
 				}
 			}
+			if isTransientGitError(errbuf.String()) {
+				err = retry.Retryable(err)
+			}
 		}
 		return buf, err
 	}
 }
 
+// transientGitErrors are messages in the output of git commands that failed
+// because of a network or server error that may not happen on a retry
+var transientGitErrors = []string{
+	"Connection reset",
+	"Connection timed out",
+	"Connection refused",
+	"early EOF",
+	"RPC failed; curl 18", // partial transfer
+	"RPC failed; curl 56", // failure receiving data
+	"RPC failed; HTTP 5",
+	"The requested URL returned error: 429",
+	"The requested URL returned error: 5",
+}
+
+func isTransientGitError(stderr string) bool {
+	for _, s := range transientGitErrors {
+		if strings.Contains(stderr, s) {
+			return true
+		}
+	}
+	return false
+}
+
 func argsNoDepth(args []string) []string {
 	out := make([]string, 0, len(args))
 	for _, a := range args {
@@ -31,6 +31,11 @@ func testRepeatedFetch(t *testing.T, keepGitDir bool) {
 	t.Parallel()
 	ctx := context.TODO()
 
+	expLen := 40
+	if keepGitDir {
+		expLen += 4
+	}
+
 	tmpdir, err := ioutil.TempDir("", "buildkit-state")
 	require.NoError(t, err)
 	defer os.RemoveAll(tmpdir)
@@ -53,7 +58,7 @@ func testRepeatedFetch(t *testing.T, keepGitDir bool) {
 	require.NoError(t, err)
 	require.True(t, done)
 
-	require.Equal(t, 40, len(key1))
+	require.Equal(t, expLen, len(key1))
 
 	ref1, err := g.Snapshot(ctx)
 	require.NoError(t, err)
@@ -140,6 +145,11 @@ func testFetchBySHA(t *testing.T, keepGitDir bool) {
 	t.Parallel()
 	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
 
+	expLen := 40
+	if keepGitDir {
+		expLen += 4
+	}
+
 	tmpdir, err := ioutil.TempDir("", "buildkit-state")
 	require.NoError(t, err)
 	defer os.RemoveAll(tmpdir)
@@ -171,7 +181,7 @@ func testFetchBySHA(t *testing.T, keepGitDir bool) {
 	require.NoError(t, err)
 	require.True(t, done)
 
-	require.Equal(t, 40, len(key1))
+	require.Equal(t, expLen, len(key1))
 
 	ref1, err := g.Snapshot(ctx)
 	require.NoError(t, err)
@@ -208,6 +218,11 @@ func testMultipleRepos(t *testing.T, keepGitDir bool) {
 	t.Parallel()
 	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
 
+	expLen := 40
+	if keepGitDir {
+		expLen += 4
+	}
+
 	tmpdir, err := ioutil.TempDir("", "buildkit-state")
 	require.NoError(t, err)
 	defer os.RemoveAll(tmpdir)
@@ -246,11 +261,11 @@ func testMultipleRepos(t *testing.T, keepGitDir bool) {
 
 	key1, _, err := g.CacheKey(ctx, 0)
 	require.NoError(t, err)
-	require.Equal(t, 40, len(key1))
+	require.Equal(t, expLen, len(key1))
 
 	key2, _, err := g2.CacheKey(ctx, 0)
 	require.NoError(t, err)
-	require.Equal(t, 40, len(key2))
+	require.Equal(t, expLen, len(key2))
 
 	require.NotEqual(t, key1, key2)
 
@@ -289,6 +304,79 @@ func testMultipleRepos(t *testing.T, keepGitDir bool) {
 	require.Equal(t, "xyz\n", string(dt))
 }
 
+func TestSubdir(t *testing.T) {
+	t.Parallel()
+	ctx := namespaces.WithNamespace(context.Background(), "buildkit-test")
+
+	tmpdir, err := ioutil.TempDir("", "buildkit-state")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	gs := setupGitSource(t, tmpdir)
+
+	repodir, err := ioutil.TempDir("", "buildkit-gitsource")
+	require.NoError(t, err)
+	defer os.RemoveAll(repodir)
+
+	err = runShell(repodir,
+		"git init",
+		"git config --local user.email test",
+		"git config --local user.name test",
+		"echo foo > abc",
+		"mkdir -p sub/dir other",
+		"echo bar > sub/dir/def",
+		"echo baz > other/ghi",
+		"git add -A",
+		"git commit -m initial",
+	)
+	require.NoError(t, err)
+
+	g, err := gs.Resolve(ctx, &source.GitIdentifier{Remote: repodir})
+	require.NoError(t, err)
+
+	key1, _, err := g.CacheKey(ctx, 0)
+	require.NoError(t, err)
+
+	id := &source.GitIdentifier{Remote: repodir, Subdir: "sub"}
+
+	g, err = gs.Resolve(ctx, id)
+	require.NoError(t, err)
+
+	key2, _, err := g.CacheKey(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, key1+":sub", key2)
+
+	ref, err := g.Snapshot(ctx)
+	require.NoError(t, err)
+	defer ref.Release(context.TODO())
+
+	mount, err := ref.Mount(ctx, false)
+	require.NoError(t, err)
+
+	lm := snapshot.LocalMounter(mount)
+	dir, err := lm.Mount()
+	require.NoError(t, err)
+	defer lm.Unmount()
+
+	fis, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fis))
+	require.Equal(t, "dir", fis[0].Name())
+
+	dt, err := ioutil.ReadFile(filepath.Join(dir, "dir/def"))
+	require.NoError(t, err)
+	require.Equal(t, "bar\n", string(dt))
+
+	id = &source.GitIdentifier{Remote: repodir, Subdir: "abc"}
+
+	g, err = gs.Resolve(ctx, id)
+	require.NoError(t, err)
+
+	_, err = g.Snapshot(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not a directory")
+}
+
 func setupGitSource(t *testing.T, tmpdir string) source.Source {
 	snapshotter, err := native.NewSnapshotter(filepath.Join(tmpdir, "snapshots"))
 	assert.NoError(t, err)
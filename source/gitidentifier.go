@@ -2,9 +2,8 @@ package source
 
 import (
 	"net/url"
+	"path"
 	"strings"
-
-	"github.com/pkg/errors"
 )
 
 type GitIdentifier struct {
@@ -12,6 +11,13 @@ type GitIdentifier struct {
 	Ref        string
 	Subdir     string
 	KeepGitDir bool
+	// AuthTokenSecret and AuthHeaderSecret are the IDs of the session
+	// secrets used to authenticate to HTTP remotes
+	AuthTokenSecret  string
+	AuthHeaderSecret string
+	// MountSSHSock is the ID of the session SSH agent used for SSH remotes
+	MountSSHSock  string
+	KnownSSHHosts string
 }
 
 func NewGitIdentifier(remoteURL string) (*GitIdentifier, error) {
@@ -41,9 +47,6 @@ func NewGitIdentifier(remoteURL string) (*GitIdentifier, error) {
 		u.Fragment = ""
 		repo.Remote = u.String()
 	}
-	if repo.Subdir != "" {
-		return nil, errors.Errorf("subdir not supported yet")
-	}
 	return &repo, nil
 }
 
@@ -64,7 +67,8 @@ func getRefAndSubdir(fragment string) (ref string, subdir string) {
 		ref = refAndDir[0]
 	}
 	if len(refAndDir) > 1 && len(refAndDir[1]) != 0 {
-		subdir = refAndDir[1]
+		// subdir can't point outside of the repository
+		subdir = strings.TrimPrefix(path.Clean("/"+refAndDir[1]), "/")
 	}
 	return
 }
@@ -18,8 +18,11 @@ import (
 	"github.com/docker/docker/pkg/locker"
 	"github.com/moby/buildkit/cache"
 	"github.com/moby/buildkit/cache/metadata"
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/secrets"
 	"github.com/moby/buildkit/snapshot"
 	"github.com/moby/buildkit/source"
+	"github.com/moby/buildkit/util/retry"
 	"github.com/moby/buildkit/util/tracing"
 	digest "github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
@@ -27,9 +30,10 @@ import (
 )
 
 type Opt struct {
-	CacheAccessor cache.Accessor
-	MetadataStore *metadata.Store
-	Transport     http.RoundTripper
+	CacheAccessor  cache.Accessor
+	MetadataStore  *metadata.Store
+	Transport      http.RoundTripper
+	SessionManager *session.Manager // optional, needed for auth headers
 }
 
 type httpSource struct {
@@ -37,6 +41,7 @@ type httpSource struct {
 	cache  cache.Accessor
 	locker *locker.Locker
 	client *http.Client
+	sm     *session.Manager
 }
 
 func NewSource(opt Opt) (source.Source, error) {
@@ -49,8 +54,9 @@ func NewSource(opt Opt) (source.Source, error) {
 		cache:  opt.CacheAccessor,
 		locker: locker.New(),
 		client: &http.Client{
-			Transport: transport,
+			Transport: retry.Transport(transport),
 		},
+		sm: opt.SessionManager,
 	}
 	return hs, nil
 }
@@ -84,11 +90,13 @@ func (hs *httpSourceHandler) urlHash() (digest.Digest, error) {
 	dt, err := json.Marshal(struct {
 		Filename       string
 		Perm, UID, GID int
+		Header         []source.HeaderField `json:",omitempty"`
 	}{
 		Filename: getFileName(hs.src.URL, hs.src.Filename, nil),
 		Perm:     hs.src.Perm,
 		UID:      hs.src.UID,
 		GID:      hs.src.GID,
+		Header:   hs.src.Header,
 	})
 	if err != nil {
 		return "", err
@@ -133,11 +141,10 @@ func (hs *httpSourceHandler) CacheKey(ctx context.Context, index int) (string, b
 		return "", false, errors.Wrapf(err, "failed to search metadata for %s", uh)
 	}
 
-	req, err := http.NewRequest("GET", hs.src.URL, nil)
+	req, err := hs.newHTTPRequest(ctx)
 	if err != nil {
 		return "", false, err
 	}
-	req = req.WithContext(ctx)
 	m := map[string]*metadata.StorageItem{}
 
 	if len(sis) > 0 {
@@ -171,6 +178,7 @@ func (hs *httpSourceHandler) CacheKey(ctx context.Context, index int) (string, b
 		if dgst == "" {
 			return "", false, errors.Errorf("invalid metadata change")
 		}
+		hs.cacheKey = dgst
 		modTime := getModTime(si)
 		resp.Body.Close()
 		return hs.formatCacheKey(getFileName(hs.src.URL, hs.src.Filename, resp), dgst, modTime).String(), true, nil
@@ -187,6 +195,51 @@ func (hs *httpSourceHandler) CacheKey(ctx context.Context, index int) (string, b
 	return hs.formatCacheKey(getFileName(hs.src.URL, hs.src.Filename, resp), dgst, resp.Header.Get("Last-Modified")).String(), true, nil
 }
 
+// newHTTPRequest returns the GET request for the source. The Authorization
+// header is read from the session on every request so that it never ends up
+// in the cache metadata.
+func (hs *httpSourceHandler) newHTTPRequest(ctx context.Context) (*http.Request, error) {
+	req, err := http.NewRequest("GET", hs.src.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	for _, h := range hs.src.Header {
+		req.Header.Set(h.Name, h.Value)
+	}
+
+	if hs.src.AuthHeaderSecret != "" {
+		auth, err := hs.getAuthHeader(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", auth)
+	}
+	return req, nil
+}
+
+func (hs *httpSourceHandler) getAuthHeader(ctx context.Context) (string, error) {
+	sessionID := session.FromContext(ctx)
+	if sessionID == "" || hs.sm == nil {
+		return "", errors.Errorf("could not access auth header for %s without session", hs.src.URL)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	caller, err := hs.sm.Get(timeoutCtx, sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	dt, err := secrets.GetSecret(ctx, caller, hs.src.AuthHeaderSecret)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get auth header for %s", hs.src.URL)
+	}
+	return strings.TrimSpace(string(dt)), nil
+}
+
 func (hs *httpSourceHandler) save(ctx context.Context, resp *http.Response) (ref cache.ImmutableRef, dgst digest.Digest, retErr error) {
 	newRef, err := hs.cache.New(ctx, nil, cache.CachePolicyRetain, cache.WithDescription(fmt.Sprintf("http url %s", hs.src.URL)))
 	if err != nil {
@@ -295,6 +348,10 @@ func (hs *httpSourceHandler) save(ctx context.Context, resp *http.Response) (ref
 	return ref, dgst, nil
 }
 
+func (hs *httpSourceHandler) Pin() string {
+	return hs.cacheKey.String()
+}
+
 func (hs *httpSourceHandler) Snapshot(ctx context.Context) (cache.ImmutableRef, error) {
 	if hs.refID != "" {
 		ref, err := hs.cache.Get(ctx, hs.refID)
@@ -303,11 +360,10 @@ func (hs *httpSourceHandler) Snapshot(ctx context.Context) (cache.ImmutableRef,
 		}
 	}
 
-	req, err := http.NewRequest("GET", hs.src.URL, nil)
+	req, err := hs.newHTTPRequest(ctx)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
 
 	resp, err := hs.client.Do(req)
 	if err != nil {
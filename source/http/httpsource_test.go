@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
 	"testing"
@@ -11,11 +12,17 @@ import (
 	"github.com/moby/buildkit/cache"
 	"github.com/moby/buildkit/cache/metadata"
 	"github.com/moby/buildkit/identity"
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/secrets"
+	"github.com/moby/buildkit/session/secrets/secretsprovider"
+	"github.com/moby/buildkit/session/testutil"
 	"github.com/moby/buildkit/snapshot"
 	"github.com/moby/buildkit/source"
 	"github.com/moby/buildkit/util/testutil/httpserver"
 	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 )
 
 func TestHTTPSource(t *testing.T) {
@@ -26,7 +33,7 @@ func TestHTTPSource(t *testing.T) {
 	require.NoError(t, err)
 	defer os.RemoveAll(tmpdir)
 
-	hs, err := newHTTPSource(tmpdir)
+	hs, err := newHTTPSource(tmpdir, nil)
 	require.NoError(t, err)
 
 	resp := httpserver.Response{
@@ -140,7 +147,7 @@ func TestHTTPDefaultName(t *testing.T) {
 	require.NoError(t, err)
 	defer os.RemoveAll(tmpdir)
 
-	hs, err := newHTTPSource(tmpdir)
+	hs, err := newHTTPSource(tmpdir, nil)
 	require.NoError(t, err)
 
 	resp := httpserver.Response{
@@ -189,7 +196,7 @@ func TestHTTPInvalidURL(t *testing.T) {
 	require.NoError(t, err)
 	defer os.RemoveAll(tmpdir)
 
-	hs, err := newHTTPSource(tmpdir)
+	hs, err := newHTTPSource(tmpdir, nil)
 	require.NoError(t, err)
 
 	server := httpserver.NewTestServer(map[string]httpserver.Response{})
@@ -213,7 +220,7 @@ func TestHTTPChecksum(t *testing.T) {
 	require.NoError(t, err)
 	defer os.RemoveAll(tmpdir)
 
-	hs, err := newHTTPSource(tmpdir)
+	hs, err := newHTTPSource(tmpdir, nil)
 	require.NoError(t, err)
 
 	resp := httpserver.Response{
@@ -281,6 +288,100 @@ func TestHTTPChecksum(t *testing.T) {
 
 }
 
+func TestHTTPAuthHeader(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	tmpdir, err := ioutil.TempDir("", "buildkit-state")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	s, err := session.NewSession(ctx, "foo", "bar")
+	require.NoError(t, err)
+
+	sm, err := session.NewManager()
+	require.NoError(t, err)
+
+	s.Allow(secretsprovider.FromMap(map[string][]byte{
+		"auth": []byte("Bearer abc\n"),
+	}))
+
+	hs, err := newHTTPSource(tmpdir, sm)
+	require.NoError(t, err)
+
+	server := httpserver.NewTestServer(map[string]httpserver.Response{
+		"/foo": {
+			Etag:    identity.NewID(),
+			Content: []byte("content1"),
+			Header: http.Header{
+				"Authorization": []string{"Bearer abc"},
+				"Accept":        []string{"application/octet-stream"},
+			},
+		},
+	})
+	defer server.Close()
+
+	dialer := session.Dialer(testutil.TestStream(testutil.Handler(sm.HandleConn)))
+
+	g, ctx := errgroup.WithContext(context.Background())
+
+	g.Go(func() error {
+		return s.Run(ctx, dialer)
+	})
+
+	g.Go(func() error {
+		defer s.Close()
+		ctx := session.NewContext(ctx, s.ID())
+
+		header := []source.HeaderField{{Name: "Accept", Value: "application/octet-stream"}}
+
+		// missing secret
+		h, err := hs.Resolve(ctx, &source.HttpIdentifier{URL: server.URL + "/foo", Header: header, AuthHeaderSecret: "nosuchsecret"})
+		require.NoError(t, err)
+		_, _, err = h.CacheKey(ctx, 0)
+		require.Error(t, err)
+		require.True(t, errors.Cause(err) == secrets.ErrNotFound)
+
+		// missing header
+		h, err = hs.Resolve(ctx, &source.HttpIdentifier{URL: server.URL + "/foo", AuthHeaderSecret: "auth"})
+		require.NoError(t, err)
+		_, _, err = h.CacheKey(ctx, 0)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid response status 401")
+
+		id := &source.HttpIdentifier{URL: server.URL + "/foo", Header: header, AuthHeaderSecret: "auth"}
+		h, err = hs.Resolve(ctx, id)
+		require.NoError(t, err)
+		k, _, err := h.CacheKey(ctx, 0)
+		require.NoError(t, err)
+		require.Equal(t, "sha256:0b1a154faa3003c1fbe7fda9c8a42d55fde2df2a2c405c32038f8ac7ed6b044a", k)
+
+		ref, err := h.Snapshot(ctx)
+		require.NoError(t, err)
+		dt, err := readFile(ctx, ref, "foo")
+		ref.Release(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, []byte("content1"), dt)
+
+		// the auth header is needed for revalidating the etag as well
+		h, err = hs.Resolve(ctx, id)
+		require.NoError(t, err)
+		_, _, err = h.CacheKey(ctx, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, server.Stats("/foo").CachedRequests)
+
+		// without a session the secret can't be read
+		h, err = hs.Resolve(context.TODO(), id)
+		require.NoError(t, err)
+		_, _, err = h.CacheKey(context.TODO(), 0)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "without session")
+		return nil
+	})
+
+	require.NoError(t, g.Wait())
+}
+
 func readFile(ctx context.Context, ref cache.ImmutableRef, fp string) ([]byte, error) {
 	mount, err := ref.Mount(ctx, false)
 	if err != nil {
@@ -303,7 +404,7 @@ func readFile(ctx context.Context, ref cache.ImmutableRef, fp string) ([]byte, e
 	return dt, nil
 }
 
-func newHTTPSource(tmpdir string) (source.Source, error) {
+func newHTTPSource(tmpdir string, sm *session.Manager) (source.Source, error) {
 	snapshotter, err := native.NewSnapshotter(filepath.Join(tmpdir, "snapshots"))
 	if err != nil {
 		return nil, err
@@ -323,7 +424,8 @@ func newHTTPSource(tmpdir string) (source.Source, error) {
 	}
 
 	return NewSource(Opt{
-		CacheAccessor: cm,
-		MetadataStore: md,
+		CacheAccessor:  cm,
+		MetadataStore:  md,
+		SessionManager: sm,
 	})
 }
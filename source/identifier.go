@@ -2,6 +2,9 @@ package source
 
 import (
 	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -32,6 +35,7 @@ const (
 	LocalScheme       = "local"
 	HttpScheme        = "http"
 	HttpsScheme       = "https"
+	OCILayoutScheme   = "oci-layout"
 )
 
 type Identifier interface {
@@ -56,6 +60,8 @@ func FromString(s string) (Identifier, error) {
 		return NewHttpIdentifier(parts[1], true)
 	case HttpScheme:
 		return NewHttpIdentifier(parts[1], false)
+	case OCILayoutScheme:
+		return NewOCIIdentifier(parts[1])
 	default:
 		return nil, errors.Wrapf(errNotFound, "unknown schema %s", parts[0])
 	}
@@ -94,6 +100,23 @@ func FromLLB(op *pb.Op_Source, platform *pb.Platform) (Identifier, error) {
 			}
 		}
 	}
+	if id, ok := id.(*OCIIdentifier); ok {
+		if platform != nil {
+			id.Platform = &specs.Platform{
+				OS:           platform.OS,
+				Architecture: platform.Architecture,
+				Variant:      platform.Variant,
+				OSVersion:    platform.OSVersion,
+				OSFeatures:   platform.OSFeatures,
+			}
+		}
+		for k, v := range op.Source.Attrs {
+			switch k {
+			case pb.AttrOCILayoutSessionID:
+				id.SessionID = v
+			}
+		}
+	}
 	if id, ok := id.(*GitIdentifier); ok {
 		for k, v := range op.Source.Attrs {
 			switch k {
@@ -103,6 +126,14 @@ func FromLLB(op *pb.Op_Source, platform *pb.Platform) (Identifier, error) {
 				}
 			case pb.AttrFullRemoteURL:
 				id.Remote = v
+			case pb.AttrAuthHeaderSecret:
+				id.AuthHeaderSecret = v
+			case pb.AttrAuthTokenSecret:
+				id.AuthTokenSecret = v
+			case pb.AttrKnownSSHHosts:
+				id.KnownSSHHosts = v
+			case pb.AttrMountSSHSock:
+				id.MountSSHSock = v
 			}
 		}
 	}
@@ -167,8 +198,21 @@ func FromLLB(op *pb.Op_Source, platform *pb.Platform) (Identifier, error) {
 					return nil, err
 				}
 				id.GID = int(i)
+			case pb.AttrHTTPAuthHeaderSecret:
+				id.AuthHeaderSecret = v
+			default:
+				if strings.HasPrefix(k, pb.AttrHTTPHeaderPrefix) {
+					name := http.CanonicalHeaderKey(strings.TrimPrefix(k, pb.AttrHTTPHeaderPrefix))
+					if _, ok := allowedHTTPHeaders[name]; !ok {
+						return nil, errors.Errorf("header %s is not allowed for http sources", name)
+					}
+					id.Header = append(id.Header, HeaderField{Name: name, Value: v})
+				}
 			}
 		}
+		sort.Slice(id.Header, func(i, j int) bool {
+			return id.Header[i].Name < id.Header[j].Name
+		})
 	}
 	return id, nil
 }
@@ -196,6 +240,36 @@ func (_ *ImageIdentifier) ID() string {
 	return DockerImageScheme
 }
 
+// OCIIdentifier identifies an image by the digest of its manifest or index
+// in a content store holding an OCI image layout
+type OCIIdentifier struct {
+	StoreID   string
+	Digest    digest.Digest
+	Platform  *specs.Platform
+	SessionID string
+}
+
+var storeIDRegexp = regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*$`)
+
+func NewOCIIdentifier(str string) (*OCIIdentifier, error) {
+	parts := strings.SplitN(str, "@", 2)
+	if len(parts) != 2 {
+		return nil, errors.Wrapf(errInvalid, "oci-layout source %s requires a digest", str)
+	}
+	if !storeIDRegexp.MatchString(parts[0]) {
+		return nil, errors.Wrapf(errInvalid, "invalid oci-layout store ID %q", parts[0])
+	}
+	dgst, err := digest.Parse(parts[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid oci-layout source %s", str)
+	}
+	return &OCIIdentifier{StoreID: parts[0], Digest: dgst}, nil
+}
+
+func (*OCIIdentifier) ID() string {
+	return OCILayoutScheme
+}
+
 type LocalIdentifier struct {
 	Name            string
 	SessionID       string
@@ -229,6 +303,24 @@ type HttpIdentifier struct {
 	Perm     int
 	UID      int
 	GID      int
+	// AuthHeaderSecret is the ID of the session secret with the value of
+	// the Authorization header
+	AuthHeaderSecret string
+	// Header are extra headers sent with the request, sorted by name
+	Header []HeaderField
+}
+
+// HeaderField is a header of a HTTP request
+type HeaderField struct {
+	Name  string
+	Value string
+}
+
+// allowedHTTPHeaders are the headers http sources can set. Other headers
+// could change how the response is cached or routed.
+var allowedHTTPHeaders = map[string]struct{}{
+	"Accept":     {},
+	"User-Agent": {},
 }
 
 func (_ *HttpIdentifier) ID() string {
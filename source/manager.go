@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/moby/buildkit/sourcepolicy"
 	"github.com/pkg/errors"
 )
 
@@ -18,9 +20,17 @@ type SourceInstance interface {
 	Snapshot(ctx context.Context) (cache.ImmutableRef, error)
 }
 
+// Pinner is implemented by source instances that can return the immutable
+// version they were resolved to, like an image digest or a commit SHA. The
+// version is only known after CacheKey has been called.
+type Pinner interface {
+	Pin() string
+}
+
 type Manager struct {
 	mu      sync.Mutex
 	sources map[string]Source
+	policy  *sourcepolicy.Engine
 }
 
 func NewManager() (*Manager, error) {
@@ -35,6 +45,25 @@ func (sm *Manager) Register(src Source) {
 	sm.mu.Unlock()
 }
 
+// SetPolicy sets the source policy that is applied to all sources before they
+// are resolved
+func (sm *Manager) SetPolicy(e *sourcepolicy.Engine) {
+	sm.mu.Lock()
+	sm.policy = e
+	sm.mu.Unlock()
+}
+
+// Evaluate applies the source policy to op. op is changed in place if the
+// policy converts it to another source.
+func (sm *Manager) Evaluate(ctx context.Context, op *pb.SourceOp) error {
+	sm.mu.Lock()
+	e := sm.policy
+	sm.mu.Unlock()
+
+	_, err := e.Evaluate(ctx, op)
+	return err
+}
+
 func (sm *Manager) Resolve(ctx context.Context, id Identifier) (SourceInstance, error) {
 	sm.mu.Lock()
 	src, ok := sm.sources[id.ID()]
@@ -0,0 +1,234 @@
+package sourcepolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+	"github.com/moby/buildkit/solver/pb"
+	spb "github.com/moby/buildkit/sourcepolicy/pb"
+	"github.com/pkg/errors"
+)
+
+const dockerImagePrefix = "docker-image://"
+
+// maxConversions limits how many times a source can be converted so that
+// rules converting sources into each other fail instead of looping
+const maxConversions = 10
+
+// DeniedError is returned for sources denied by a policy
+type DeniedError struct {
+	Identifier string
+	Policy     string
+	Rule       int
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("source %q denied by rule %d of %s source policy", e.Identifier, e.Rule, e.Policy)
+}
+
+// Engine evaluates a source policy for source ops
+type Engine struct {
+	name  string
+	rules []rule
+}
+
+type rule struct {
+	*spb.Rule
+	re *regexp.Regexp
+}
+
+// NewEngine returns an engine evaluating pol. name describes where the policy
+// comes from and is used in errors.
+func NewEngine(name string, pol *spb.Policy) (*Engine, error) {
+	e := &Engine{name: name}
+	if pol == nil {
+		return e, nil
+	}
+	for i, r := range pol.Rules {
+		if r.Selector == nil {
+			return nil, errors.Errorf("rule %d of %s source policy has no selector", i+1, name)
+		}
+		if r.Action == spb.PolicyAction_CONVERT && (r.Updates == nil || r.Updates.Identifier == "" && len(r.Updates.Attrs) == 0) {
+			return nil, errors.Errorf("convert rule %d of %s source policy has no updates", i+1, name)
+		}
+		re, err := compileSelector(r.Selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid selector in rule %d of %s source policy", i+1, name)
+		}
+		e.rules = append(e.rules, rule{Rule: r, re: re})
+	}
+	return e, nil
+}
+
+// Evaluate applies the policy to op. The first rule matching the identifier
+// of op is used. CONVERT rules change op in place and the converted source is
+// evaluated again. A *DeniedError is returned for denied sources. The
+// returned bool reports whether op was changed.
+func (e *Engine) Evaluate(ctx context.Context, op *pb.SourceOp) (bool, error) {
+	if e == nil || len(e.rules) == 0 {
+		return false, nil
+	}
+	var mutated bool
+	for i := 0; i <= maxConversions; i++ {
+		id := normalize(op.Identifier)
+		var matched *rule
+		var idx int
+		var m []int
+		for j := range e.rules {
+			if m = e.rules[j].re.FindStringSubmatchIndex(id); m != nil {
+				matched, idx = &e.rules[j], j
+				break
+			}
+		}
+		if matched == nil {
+			return mutated, nil
+		}
+		switch matched.Action {
+		case spb.PolicyAction_ALLOW:
+			return mutated, nil
+		case spb.PolicyAction_DENY:
+			return mutated, &DeniedError{Identifier: op.Identifier, Policy: e.name, Rule: idx + 1}
+		case spb.PolicyAction_CONVERT:
+			newID := op.Identifier
+			if matched.Updates.Identifier != "" {
+				newID = string(matched.re.ExpandString(nil, matched.Updates.Identifier, id, m))
+			}
+			if len(matched.Updates.Attrs) > 0 {
+				attrs := make(map[string]string, len(op.Attrs)+len(matched.Updates.Attrs))
+				for k, v := range op.Attrs {
+					attrs[k] = v
+				}
+				for k, v := range matched.Updates.Attrs {
+					attrs[k] = v
+				}
+				op.Attrs = attrs
+				mutated = true
+			}
+			if newID == op.Identifier || normalize(newID) == id {
+				return mutated, nil
+			}
+			op.Identifier = newID
+			mutated = true
+		default:
+			return mutated, errors.Errorf("invalid action %v in rule %d of %s source policy", matched.Action, idx+1, e.name)
+		}
+	}
+	return mutated, errors.Errorf("source %q was converted more than %d times by %s source policy", op.Identifier, maxConversions, e.name)
+}
+
+func compileSelector(s *spb.Selector) (*regexp.Regexp, error) {
+	switch s.MatchType {
+	case spb.MatchType_EXACT:
+		return regexp.Compile("^" + regexp.QuoteMeta(normalize(s.Identifier)) + "$")
+	case spb.MatchType_WILDCARD:
+		i := strings.IndexAny(s.Identifier, "*?")
+		if i == -1 {
+			return regexp.Compile("^" + regexp.QuoteMeta(normalize(s.Identifier)) + "$")
+		}
+		var sb strings.Builder
+		sb.WriteString("^")
+		sb.WriteString(regexp.QuoteMeta(normalizePrefix(s.Identifier[:i])))
+		for _, c := range s.Identifier[i:] {
+			switch c {
+			case '*':
+				sb.WriteString("(.*)")
+			case '?':
+				sb.WriteString("(.)")
+			default:
+				sb.WriteString(regexp.QuoteMeta(string(c)))
+			}
+		}
+		sb.WriteString("$")
+		return regexp.Compile(sb.String())
+	case spb.MatchType_REGEX:
+		i := literalPrefixLen(s.Identifier)
+		return regexp.Compile("^(?:" + regexp.QuoteMeta(normalizePrefix(s.Identifier[:i])) + s.Identifier[i:] + ")$")
+	default:
+		return nil, errors.Errorf("invalid match type %v", s.MatchType)
+	}
+}
+
+// normalize returns the identifier with image references in their fully
+// qualified form so that policies can't be bypassed by using short names
+func normalize(id string) string {
+	if !strings.HasPrefix(id, dockerImagePrefix) {
+		return id
+	}
+	named, err := reference.ParseNormalizedNamed(strings.TrimPrefix(id, dockerImagePrefix))
+	if err != nil {
+		return id
+	}
+	return dockerImagePrefix + reference.TagNameOnly(named).String()
+}
+
+// normalizePrefix returns the literal start of a pattern for image
+// references in the fully qualified form that identifiers are matched in,
+// e.g. docker-image://docker.io/library/alpine for docker-image://alpine. A
+// prefix that may still be part of a registry domain is not changed.
+func normalizePrefix(prefix string) string {
+	name := strings.TrimPrefix(prefix, dockerImagePrefix)
+	if name == prefix || name == "" {
+		return prefix
+	}
+	if i := strings.IndexRune(name, '/'); i != -1 {
+		if domain := name[:i]; strings.ContainsAny(domain, ".:") || domain == "localhost" {
+			return prefix
+		}
+		return dockerImagePrefix + "docker.io/" + name
+	}
+	repo := name
+	if i := strings.IndexAny(repo, ":@"); i != -1 {
+		repo = repo[:i]
+	}
+	if strings.Contains(repo, ".") || repo == "localhost" {
+		return prefix
+	}
+	return dockerImagePrefix + "docker.io/library/" + name
+}
+
+// literalPrefixLen returns the length of the start of the regular expression
+// re that only matches itself. A literal followed by a quantifier is not
+// included.
+func literalPrefixLen(re string) int {
+	i := strings.IndexAny(re, `\.+*?()|[]{}^$`)
+	if i == -1 {
+		return len(re)
+	}
+	if i > 0 && strings.ContainsRune("*+?{", rune(re[i])) {
+		i--
+	}
+	return i
+}
+
+// ParsePolicy parses a JSON encoded policy. Actions and match types are
+// given by name, e.g. {"rules": [{"action": "DENY", "selector": {"identifier":
+// "docker-image://*"}}]}.
+func ParsePolicy(dt []byte) (*spb.Policy, error) {
+	var pol spb.Policy
+	dec := json.NewDecoder(bytes.NewReader(dt))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&pol); err != nil {
+		return nil, errors.Wrap(err, "failed to parse source policy")
+	}
+	return &pol, nil
+}
+
+// ImageSourceOp returns a source op for the image reference ref so that
+// policies can be applied to images that are not loaded with a source op, like
+// when resolving an image config
+func ImageSourceOp(ref string) *pb.SourceOp {
+	return &pb.SourceOp{Identifier: dockerImagePrefix + ref}
+}
+
+// ImageRef returns the image reference of an image source op
+func ImageRef(op *pb.SourceOp) (string, error) {
+	if !strings.HasPrefix(op.Identifier, dockerImagePrefix) {
+		return "", errors.Errorf("image can not be converted to source %q", op.Identifier)
+	}
+	return strings.TrimPrefix(op.Identifier, dockerImagePrefix), nil
+}
@@ -0,0 +1,132 @@
+package sourcepolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moby/buildkit/solver/pb"
+	spb "github.com/moby/buildkit/sourcepolicy/pb"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+const alpineDigest = "sha256:46e71df1e5191ab8b8034c5189e325258ec44ea739bba1e5645cff83c9048ff1"
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	pol, err := ParsePolicy([]byte(`{"rules": [
+		{"action": "CONVERT", "selector": {"identifier": "docker-image://docker.io/library/alpine:3.8", "match_type": "EXACT"}, "updates": {"identifier": "docker-image://docker.io/library/alpine:3.8@` + alpineDigest + `"}},
+		{"action": "ALLOW", "selector": {"identifier": "docker-image://docker.io/library/alpine:3.8@sha256:*"}},
+		{"action": "CONVERT", "selector": {"identifier": "git://github.com/*"}, "updates": {"identifier": "git://mirror.example.com/github/$1"}},
+		{"action": "CONVERT", "selector": {"identifier": "https://example.com/foo"}, "updates": {"attrs": {"http.checksum": "sha256:abc"}}},
+		{"action": "DENY", "selector": {"identifier": "docker-image://.*", "match_type": "REGEX"}}
+	]}`))
+	require.NoError(t, err)
+
+	e, err := NewEngine("buildkitd", pol)
+	require.NoError(t, err)
+
+	op := &pb.SourceOp{Identifier: "docker-image://alpine:3.8"}
+	mutated, err := e.Evaluate(context.TODO(), op)
+	require.NoError(t, err)
+	require.True(t, mutated)
+	require.Equal(t, "docker-image://docker.io/library/alpine:3.8@"+alpineDigest, op.Identifier)
+
+	op = &pb.SourceOp{Identifier: "git://github.com/moby/buildkit.git#master"}
+	mutated, err = e.Evaluate(context.TODO(), op)
+	require.NoError(t, err)
+	require.True(t, mutated)
+	require.Equal(t, "git://mirror.example.com/github/moby/buildkit.git#master", op.Identifier)
+
+	attrs := map[string]string{"http.filename": "foo"}
+	op = &pb.SourceOp{Identifier: "https://example.com/foo", Attrs: attrs}
+	mutated, err = e.Evaluate(context.TODO(), op)
+	require.NoError(t, err)
+	require.True(t, mutated)
+	require.Equal(t, map[string]string{"http.filename": "foo", "http.checksum": "sha256:abc"}, op.Attrs)
+	require.Equal(t, map[string]string{"http.filename": "foo"}, attrs)
+
+	op = &pb.SourceOp{Identifier: "local://context"}
+	mutated, err = e.Evaluate(context.TODO(), op)
+	require.NoError(t, err)
+	require.False(t, mutated)
+
+	op = &pb.SourceOp{Identifier: "docker-image://busybox"}
+	_, err = e.Evaluate(context.TODO(), op)
+	require.Error(t, err)
+	denied, ok := errors.Cause(err).(*DeniedError)
+	require.True(t, ok)
+	require.Equal(t, "docker-image://busybox", denied.Identifier)
+	require.Equal(t, 5, denied.Rule)
+	require.Contains(t, err.Error(), "buildkitd source policy")
+}
+
+func TestEvaluatePatterns(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		selector spb.Selector
+		id       string
+		match    bool
+	}{
+		{spb.Selector{Identifier: "docker-image://alpine*"}, "docker-image://alpine:3.8", true},
+		{spb.Selector{Identifier: "docker-image://alpine*"}, "docker-image://docker.io/library/alpine", true},
+		{spb.Selector{Identifier: "docker-image://alpine:3.?"}, "docker-image://alpine:3.8", true},
+		{spb.Selector{Identifier: "docker-image://alpine:3.?"}, "docker-image://alpine:3.10", false},
+		{spb.Selector{Identifier: "docker-image://alpine:3.8"}, "docker-image://alpine:3.8", true},
+		{spb.Selector{Identifier: "docker-image://foo/*"}, "docker-image://foo/bar", true},
+		{spb.Selector{Identifier: "docker-image://docker.io/library/*"}, "docker-image://alpine", true},
+		{spb.Selector{Identifier: "docker-image://gcr.io/*"}, "docker-image://gcr.io/foo/bar:v1", true},
+		{spb.Selector{Identifier: "docker-image://gcr.io*"}, "docker-image://alpine", false},
+		{spb.Selector{Identifier: "docker-image://localhost:5000/*"}, "docker-image://localhost:5000/foo", true},
+		{spb.Selector{Identifier: "docker-image://alpine:3\\.[0-9]+", MatchType: spb.MatchType_REGEX}, "docker-image://alpine:3.8", true},
+		{spb.Selector{Identifier: "docker-image://alpine.*", MatchType: spb.MatchType_REGEX}, "docker-image://alpine:3.8", true},
+		{spb.Selector{Identifier: "docker-image://alpinex?:.*", MatchType: spb.MatchType_REGEX}, "docker-image://alpine:3.8", true},
+		{spb.Selector{Identifier: "docker-image://busybox.*", MatchType: spb.MatchType_REGEX}, "docker-image://alpine:3.8", false},
+		{spb.Selector{Identifier: "git://github.com/*"}, "git://github.com/moby/buildkit.git", true},
+	} {
+		re, err := compileSelector(&tc.selector)
+		require.NoError(t, err, tc.selector.Identifier)
+		require.Equal(t, tc.match, re.MatchString(normalize(tc.id)), "%s %s", tc.selector.Identifier, tc.id)
+	}
+}
+
+func TestEvaluateLoop(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewEngine("client", &spb.Policy{
+		Rules: []*spb.Rule{
+			{
+				Action:   spb.PolicyAction_CONVERT,
+				Selector: &spb.Selector{Identifier: "docker-image://docker.io/library/alpine:*"},
+				Updates:  &spb.Update{Identifier: "docker-image://docker.io/library/alpine:latest-$1"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = e.Evaluate(context.TODO(), &pb.SourceOp{Identifier: "docker-image://alpine:3.8"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "converted more than")
+}
+
+func TestNewEngineInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewEngine("client", &spb.Policy{
+		Rules: []*spb.Rule{{Action: spb.PolicyAction_CONVERT, Selector: &spb.Selector{Identifier: "local://*"}}},
+	})
+	require.Error(t, err)
+
+	_, err = NewEngine("client", &spb.Policy{
+		Rules: []*spb.Rule{{Action: spb.PolicyAction_DENY, Selector: &spb.Selector{Identifier: "(", MatchType: spb.MatchType_REGEX}}},
+	})
+	require.Error(t, err)
+
+	_, err = ParsePolicy([]byte(`{"rules": [{"action": "REJECT"}]}`))
+	require.Error(t, err)
+
+	_, err = ParsePolicy([]byte(`{"rule": []}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,3 @@
+package moby_buildkit_v1_sourcepolicy
+
+//go:generate protoc -I=. -I=../../vendor/ --gogofaster_out=. policy.proto
@@ -0,0 +1,54 @@
+package moby_buildkit_v1_sourcepolicy
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// MarshalJSON encodes the action by its name
+func (a PolicyAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON decodes the action from its name or number
+func (a *PolicyAction) UnmarshalJSON(dt []byte) error {
+	v, err := unmarshalEnum(dt, PolicyAction_value)
+	if err != nil {
+		return errors.Wrap(err, "invalid policy action")
+	}
+	*a = PolicyAction(v)
+	return nil
+}
+
+// MarshalJSON encodes the match type by its name
+func (m MatchType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON decodes the match type from its name or number
+func (m *MatchType) UnmarshalJSON(dt []byte) error {
+	v, err := unmarshalEnum(dt, MatchType_value)
+	if err != nil {
+		return errors.Wrap(err, "invalid match type")
+	}
+	*m = MatchType(v)
+	return nil
+}
+
+func unmarshalEnum(dt []byte, values map[string]int32) (int32, error) {
+	var s string
+	if err := json.Unmarshal(dt, &s); err != nil {
+		v, err := strconv.ParseInt(string(dt), 10, 32)
+		if err != nil {
+			return 0, errors.Errorf("%s is not a string or a number", dt)
+		}
+		return int32(v), nil
+	}
+	v, ok := values[s]
+	if !ok {
+		return 0, errors.Errorf("unknown value %q", s)
+	}
+	return v, nil
+}
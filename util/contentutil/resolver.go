@@ -0,0 +1,69 @@
+package contentutil
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/remotes"
+	"github.com/moby/buildkit/util/imageutil"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// ProviderResolver returns a resolver for references with a digest to a
+// manifest or index in p, e.g. the content store of an OCI image layout.
+// Pushing is not supported.
+func ProviderResolver(p content.Provider) remotes.Resolver {
+	return &providerResolver{p: p}
+}
+
+type providerResolver struct {
+	p content.Provider
+}
+
+func (r *providerResolver) Resolve(ctx context.Context, ref string) (string, ocispec.Descriptor, error) {
+	i := strings.LastIndex(ref, "@")
+	if i == -1 {
+		return "", ocispec.Descriptor{}, errors.Errorf("reference %s has no digest", ref)
+	}
+	dgst, err := digest.Parse(ref[i+1:])
+	if err != nil {
+		return "", ocispec.Descriptor{}, errors.Wrapf(err, "invalid reference %s", ref)
+	}
+	ra, err := r.p.ReaderAt(ctx, ocispec.Descriptor{Digest: dgst})
+	if err != nil {
+		return "", ocispec.Descriptor{}, errors.Wrapf(err, "failed to resolve %s", ref)
+	}
+	defer ra.Close()
+	mt, err := imageutil.DetectManifestMediaType(ra)
+	if err != nil {
+		return "", ocispec.Descriptor{}, errors.Wrapf(err, "failed to detect media type of %s", ref)
+	}
+	return ref, ocispec.Descriptor{
+		MediaType: mt,
+		Digest:    dgst,
+		Size:      ra.Size(),
+	}, nil
+}
+
+func (r *providerResolver) Fetcher(ctx context.Context, ref string) (remotes.Fetcher, error) {
+	return remotes.FetcherFunc(func(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+		ra, err := r.p.ReaderAt(ctx, desc)
+		if err != nil {
+			return nil, err
+		}
+		return &readCloser{Reader: content.NewReader(ra), Closer: ra}, nil
+	}), nil
+}
+
+func (r *providerResolver) Pusher(ctx context.Context, ref string) (remotes.Pusher, error) {
+	return nil, errors.Errorf("pushing to %s is not supported", ref)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
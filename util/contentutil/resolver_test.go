@@ -0,0 +1,53 @@
+package contentutil
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/images"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderResolver(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	b := NewBuffer()
+
+	dt := []byte(`{"schemaVersion":2,"manifests":[]}`)
+	dgst := digest.FromBytes(dt)
+	err := content.WriteBlob(ctx, b, "index", bytes.NewBuffer(dt), ocispec.Descriptor{Size: -1})
+	require.NoError(t, err)
+
+	r := ProviderResolver(b)
+
+	name, desc, err := r.Resolve(ctx, "store@"+dgst.String())
+	require.NoError(t, err)
+	require.Equal(t, "store@"+dgst.String(), name)
+	require.Equal(t, dgst, desc.Digest)
+	require.Equal(t, int64(len(dt)), desc.Size)
+	require.Equal(t, images.MediaTypeDockerSchema2ManifestList, desc.MediaType)
+
+	_, _, err = r.Resolve(ctx, "store:latest")
+	require.Error(t, err)
+
+	_, _, err = r.Resolve(ctx, "store@"+digest.FromBytes([]byte("foo")).String())
+	require.Error(t, err)
+
+	f, err := r.Fetcher(ctx, name)
+	require.NoError(t, err)
+	rc, err := f.Fetch(ctx, desc)
+	require.NoError(t, err)
+	dt2, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	require.Equal(t, dt, dt2)
+
+	_, err = r.Pusher(ctx, name)
+	require.Error(t, err)
+}
@@ -6,7 +6,7 @@ type Entitlement string
 
 const (
 	EntitlementSecurityConfined   Entitlement = "security.confined"
-	EntitlementSecurityUnconfined Entitlement = "security.unconfined" // unimplemented
+	EntitlementSecurityUnconfined Entitlement = "security.unconfined"
 	EntitlementNetworkHost        Entitlement = "network.host"
 	EntitlementNetworkNone        Entitlement = "network.none"
 )
@@ -0,0 +1,36 @@
+// Package iostream provides the standard streams of processes that are run
+// in containers on behalf of a remote client
+package iostream
+
+import (
+	"io"
+	"os"
+)
+
+// StdinPipe returns a pipe for the stdin of a process. Stdin is a file so that
+// the executor does not wait for more input after the process has exited.
+func StdinPipe() (r *os.File, w *os.File, err error) {
+	return os.Pipe()
+}
+
+// NewWriter returns a writer for the stdout or stderr of a process that
+// calls send with each write
+func NewWriter(send func([]byte) error) io.WriteCloser {
+	return &writer{send: send}
+}
+
+type writer struct {
+	send func([]byte) error
+}
+
+func (w *writer) Write(dt []byte) (int, error) {
+	// the stream may keep the slice after Write returns
+	if err := w.send(append([]byte{}, dt...)); err != nil {
+		return 0, err
+	}
+	return len(dt), nil
+}
+
+func (w *writer) Close() error {
+	return nil
+}
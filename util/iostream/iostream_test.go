@@ -0,0 +1,30 @@
+package iostream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	var sent [][]byte
+	w := NewWriter(func(dt []byte) error {
+		sent = append(sent, dt)
+		return nil
+	})
+
+	buf := []byte("foo")
+	n, err := w.Write(buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	// the sent data is not changed when the buffer is reused
+	copy(buf, "bar")
+	_, err = w.Write(buf)
+	require.NoError(t, err)
+
+	require.Equal(t, [][]byte{[]byte("foo"), []byte("bar")}, sent)
+	require.NoError(t, w.Close())
+}
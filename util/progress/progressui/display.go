@@ -52,6 +52,7 @@ func DisplaySolveStatus(ctx context.Context, phase string, c console.Console, w
 			if done {
 				disp.print(t.displayInfo(), true)
 				t.printErrorLogs(c)
+				t.printWarnings(c)
 				return nil
 			} else if displayLimiter.Allow() {
 				disp.print(t.displayInfo(), false)
@@ -60,6 +61,7 @@ func DisplaySolveStatus(ctx context.Context, phase string, c console.Console, w
 			if done || displayLimiter.Allow() {
 				printer.print(t)
 				if done {
+					t.printWarnings(w)
 					return nil
 				}
 			}
@@ -90,6 +92,7 @@ type trace struct {
 	byDigest      map[digest.Digest]*vertex
 	nextIndex     int
 	updates       map[digest.Digest]struct{}
+	warnings      []*client.VertexWarning
 }
 
 type vertex struct {
@@ -233,6 +236,16 @@ func (t *trace) update(s *client.SolveStatus) {
 		t.updates[v.Digest] = struct{}{}
 		v.update(1)
 	}
+	for _, w := range s.Warnings {
+		t.warnings = append(t.warnings, w)
+		v, ok := t.byDigest[w.Vertex]
+		if !ok {
+			continue
+		}
+		v.events = append(v.events, fmt.Sprintf("%s: %s", warnLevelName(w.Level), w.Short))
+		t.updates[v.Digest] = struct{}{}
+		v.update(1)
+	}
 }
 
 func (t *trace) printErrorLogs(f io.Writer) {
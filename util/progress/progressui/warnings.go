@@ -0,0 +1,60 @@
+package progressui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	gw "github.com/moby/buildkit/frontend/gateway/client"
+	"github.com/moby/buildkit/solver/pb"
+)
+
+func (t *trace) printWarnings(f io.Writer) {
+	if len(t.warnings) == 0 {
+		return
+	}
+	fmt.Fprintln(f)
+	for _, w := range t.warnings {
+		fmt.Fprintf(f, "%s: %s\n", warnLevelName(w.Level), w.Short)
+		for _, d := range w.Detail {
+			fmt.Fprintf(f, "  %s\n", d)
+		}
+		printSource(f, w.SourceInfo, w.Range)
+	}
+}
+
+func warnLevelName(l int) string {
+	switch l {
+	case gw.WarnLevelInfo:
+		return "INFO"
+	case gw.WarnLevelError:
+		return "ERROR"
+	default:
+		return "WARNING"
+	}
+}
+
+// printSource prints the lines of a source file that are in the ranges,
+// prefixed with the line numbers
+func printSource(f io.Writer, info *pb.SourceInfo, ranges []*pb.Range) {
+	if info == nil || len(ranges) == 0 {
+		return
+	}
+	lines := bytes.Split(info.Data, []byte("\n"))
+	for _, r := range ranges {
+		if r == nil {
+			continue
+		}
+		fmt.Fprintf(f, "  %s:%d\n", info.Filename, r.Start.Line)
+		end := r.End.Line
+		if end < r.Start.Line {
+			end = r.Start.Line
+		}
+		for i := r.Start.Line; i <= end; i++ {
+			if i < 1 || int(i) > len(lines) {
+				break
+			}
+			fmt.Fprintf(f, "  %4d | %s\n", i, lines[i-1])
+		}
+	}
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/containerd/containerd/rootfs"
 	ctdsnapshot "github.com/containerd/containerd/snapshots"
 	"github.com/moby/buildkit/snapshot"
+	"github.com/moby/buildkit/util/contentutil"
 	"github.com/moby/buildkit/util/imageutil"
 	"github.com/moby/buildkit/util/progress"
+	"github.com/moby/buildkit/util/retry"
 	digest "github.com/opencontainers/go-digest"
 	"github.com/opencontainers/image-spec/identity"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
@@ -130,7 +132,7 @@ func (p *Puller) Pull(ctx context.Context) (*Pulled, error) {
 		childrenHandler = images.LimitManifests(childrenHandler, platform, 1)
 
 		handlers = append(handlers,
-			remotes.FetchHandler(p.ContentStore, fetcher),
+			retry.Handler(remotes.FetchHandler(p.ContentStore, fetcher)),
 			childrenHandler,
 		)
 	}
@@ -185,10 +187,9 @@ func (p *Puller) Pull(ctx context.Context) (*Pulled, error) {
 	var notLayerBlobs []ocispec.Descriptor
 	var layerBlobs []ocispec.Descriptor
 	for _, j := range usedBlobs {
-		switch j.MediaType {
-		case ocispec.MediaTypeImageLayer, images.MediaTypeDockerSchema2Layer, ocispec.MediaTypeImageLayerGzip, images.MediaTypeDockerSchema2LayerGzip, images.MediaTypeDockerSchema2LayerForeign, images.MediaTypeDockerSchema2LayerForeignGzip:
+		if isLayer(j.MediaType) {
 			layerBlobs = append(layerBlobs, j)
-		default:
+		} else {
 			notLayerBlobs = append(notLayerBlobs, j)
 		}
 	}
@@ -232,6 +233,125 @@ func (p *Puller) Pull(ctx context.Context) (*Pulled, error) {
 	}, nil
 }
 
+// PullManifests fetches the manifests and the config of the image without
+// its layers. The layer blobs are fetched through the returned provider when
+// their contents are needed. Schema1 images are not supported because their
+// layers need to be fetched to convert the manifest.
+func (p *Puller) PullManifests(ctx context.Context) (*PulledManifests, error) {
+	if _, _, err := p.Resolve(ctx); err != nil {
+		return nil, err
+	}
+	if p.desc.MediaType == images.MediaTypeDockerSchema1Manifest {
+		return nil, errors.Errorf("lazy pull not supported for schema1 image %s", p.Src)
+	}
+
+	var platform platforms.MatchComparer
+	if p.Platform != nil {
+		platform = platforms.Only(*p.Platform)
+	} else {
+		platform = platforms.Default()
+	}
+
+	ongoing := newJobs(p.ref)
+
+	pctx, stopProgress := context.WithCancel(ctx)
+
+	go showProgress(pctx, ongoing, p.ContentStore)
+
+	fetcher, err := p.Resolver.Fetcher(ctx, p.ref)
+	if err != nil {
+		stopProgress()
+		return nil, err
+	}
+
+	var mu sync.Mutex // images.Dispatch calls handlers in parallel
+	var notLayerBlobs []ocispec.Descriptor
+
+	fetchHandler := retry.Handler(remotes.FetchHandler(p.ContentStore, fetcher))
+	childrenHandler := images.ChildrenHandler(p.ContentStore)
+	childrenHandler = images.SetChildrenLabels(p.ContentStore, childrenHandler)
+	childrenHandler = images.FilterPlatforms(childrenHandler, platform)
+	childrenHandler = images.LimitManifests(childrenHandler, platform, 1)
+
+	handlers := []images.Handler{
+		images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+			if isLayer(desc.MediaType) {
+				return nil, images.ErrSkipDesc
+			}
+			ongoing.add(desc)
+			mu.Lock()
+			notLayerBlobs = append(notLayerBlobs, desc)
+			mu.Unlock()
+			return nil, nil
+		}),
+		fetchHandler,
+		childrenHandler,
+	}
+
+	if err := images.Dispatch(ctx, images.Handlers(handlers...), p.desc); err != nil {
+		stopProgress()
+		return nil, err
+	}
+	stopProgress()
+
+	layers, err := getLayers(ctx, p.ContentStore, p.desc, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	// the manifests are only needed for finding the layers. layers that are
+	// in the content store already are not fetched again.
+	for _, nl := range notLayerBlobs {
+		if err := p.ContentStore.Delete(ctx, nl.Digest); err != nil {
+			return nil, err
+		}
+	}
+
+	remote := contentutil.FromFetcher(fetcher)
+	provider := contentutil.NewMultiProvider(p.ContentStore)
+	descs := make([]ocispec.Descriptor, len(layers))
+	for i, l := range layers {
+		desc := l.Blob
+		annotations := map[string]string{}
+		for k, v := range desc.Annotations {
+			annotations[k] = v
+		}
+		annotations["containerd.io/uncompressed"] = l.Diff.Digest.String()
+		desc.Annotations = annotations
+		descs[i] = desc
+		if _, err := p.ContentStore.Info(ctx, desc.Digest); err != nil {
+			provider.Add(desc.Digest, remote)
+		}
+	}
+
+	return &PulledManifests{
+		Ref:        p.ref,
+		Descriptor: p.desc,
+		Layers:     descs,
+		Provider:   provider,
+	}, nil
+}
+
+// PulledManifests is the result of PullManifests
+type PulledManifests struct {
+	Ref        string
+	Descriptor ocispec.Descriptor
+	// Layers are the layer blobs of the image. The uncompressed digest of
+	// each layer is in the containerd.io/uncompressed annotation.
+	Layers []ocispec.Descriptor
+	// Provider fetches the layer blobs
+	Provider content.Provider
+}
+
+func isLayer(mediaType string) bool {
+	switch mediaType {
+	case ocispec.MediaTypeImageLayer, images.MediaTypeDockerSchema2Layer, ocispec.MediaTypeImageLayerGzip, images.MediaTypeDockerSchema2LayerGzip, images.MediaTypeDockerSchema2LayerForeign, images.MediaTypeDockerSchema2LayerForeignGzip:
+		return true
+	default:
+		return false
+	}
+}
+
 func unpack(ctx context.Context, desc ocispec.Descriptor, cs content.Store, csh ctdsnapshot.Snapshotter, s snapshot.Snapshotter, applier diff.Applier, platform platforms.MatchComparer) (digest.Digest, error) {
 	layers, err := getLayers(ctx, cs, desc, platform)
 	if err != nil {
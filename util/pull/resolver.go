@@ -12,6 +12,7 @@ import (
 	"github.com/moby/buildkit/session/auth"
 	"github.com/moby/buildkit/source"
 	"github.com/moby/buildkit/util/resolver"
+	"github.com/moby/buildkit/util/retry"
 	"github.com/moby/buildkit/util/tracing"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 )
@@ -24,6 +25,7 @@ func NewResolver(ctx context.Context, rfn resolver.ResolveOptionsFunc, sm *sessi
 		opt = rfn(ref)
 	}
 	opt.Credentials = getCredentialsFromSession(ctx, sm)
+	opt.Client = retry.Client(opt.Client)
 
 	r := docker.NewResolver(opt)
 
@@ -0,0 +1,137 @@
+package push
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+	digest "github.com/opencontainers/go-digest"
+)
+
+// mountSources returns the repositories in the registry of target that the
+// blobs in from can be mounted from
+func mountSources(target reference.Named, from map[digest.Digest]string) map[digest.Digest]string {
+	m := map[digest.Digest]string{}
+	for dgst, ref := range from {
+		parsed, err := reference.ParseNormalizedNamed(ref)
+		if err != nil {
+			continue
+		}
+		if reference.Domain(parsed) != reference.Domain(target) || reference.Path(parsed) == reference.Path(target) {
+			continue
+		}
+		m[dgst] = reference.Path(parsed)
+	}
+	return m
+}
+
+// mountTransport mounts blobs from other repositories of the registry when
+// the pusher checks if they exist. If the registry mounts the blob, the check
+// succeeds and the blob is not uploaded.
+type mountTransport struct {
+	http.RoundTripper
+	sources map[digest.Digest]string
+}
+
+func (t *mountTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil || req.Method != http.MethodHead || resp.StatusCode != http.StatusNotFound {
+		return resp, err
+	}
+	repo, dgst, ok := parseBlobPath(req.URL.Path)
+	if !ok {
+		return resp, nil
+	}
+	from, ok := t.sources[dgst]
+	if !ok {
+		return resp, nil
+	}
+	if mounted := t.mount(req, repo, dgst, from); mounted {
+		resp.Body.Close()
+		return &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Proto:      resp.Proto,
+			ProtoMajor: resp.ProtoMajor,
+			ProtoMinor: resp.ProtoMinor,
+			Header: http.Header{
+				"Docker-Content-Digest": []string{dgst.String()},
+			},
+			Body:    ioutil.NopCloser(bytes.NewReader(nil)),
+			Request: req,
+		}, nil
+	}
+	return resp, nil
+}
+
+// mount asks the registry to mount dgst from the repository from to repo
+func (t *mountTransport) mount(req *http.Request, repo string, dgst digest.Digest, from string) bool {
+	u := *req.URL
+	u.Path = "/v2/" + repo + "/blobs/uploads/"
+	u.RawQuery = url.Values{"mount": {dgst.String()}, "from": {from}}.Encode()
+	mreq, err := http.NewRequest(http.MethodPost, u.String(), nil)
+	if err != nil {
+		return false
+	}
+	mreq = mreq.WithContext(req.Context())
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		mreq.Header.Set("Authorization", auth)
+	}
+	resp, err := t.RoundTripper.RoundTrip(mreq)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		return true
+	case http.StatusAccepted:
+		// the registry started a regular upload instead, cancel it
+		if loc, err := resp.Location(); err == nil {
+			if dreq, err := http.NewRequest(http.MethodDelete, loc.String(), nil); err == nil {
+				dreq = dreq.WithContext(req.Context())
+				dreq.Header = mreq.Header
+				if resp, err := t.RoundTripper.RoundTrip(dreq); err == nil {
+					resp.Body.Close()
+				}
+			}
+		}
+	}
+	return false
+}
+
+// parseBlobPath returns the repository and digest of a /v2/<repo>/blobs/<digest>
+// request path
+func parseBlobPath(p string) (string, digest.Digest, bool) {
+	if !strings.HasPrefix(p, "/v2/") {
+		return "", "", false
+	}
+	i := strings.LastIndex(p, "/blobs/")
+	if i == -1 {
+		return "", "", false
+	}
+	dgst, err := digest.Parse(p[i+len("/blobs/"):])
+	if err != nil {
+		return "", "", false
+	}
+	return p[len("/v2/"):i], dgst, true
+}
+
+func withMountTransport(c *http.Client, sources map[digest.Digest]string) *http.Client {
+	if len(sources) == 0 {
+		return c
+	}
+	if c == nil {
+		c = http.DefaultClient
+	}
+	rt := c.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	nc := *c
+	nc.Transport = &mountTransport{RoundTripper: rt, sources: sources}
+	return &nc
+}
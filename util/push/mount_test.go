@@ -0,0 +1,73 @@
+package push
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/docker/distribution/reference"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMountTransport(t *testing.T) {
+	t.Parallel()
+
+	mounted := digest.FromString("mounted")
+	uploaded := digest.FromString("uploaded")
+
+	var deleted bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/foo/bar/blobs/uploads/":
+			require.Equal(t, "library/alpine", r.URL.Query().Get("from"))
+			require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			if r.URL.Query().Get("mount") == mounted.String() {
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.Header().Set("Location", "/v2/foo/bar/blobs/uploads/123")
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v2/foo/bar/blobs/uploads/123":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	target, err := reference.ParseNormalizedNamed("docker.io/foo/bar:latest")
+	require.NoError(t, err)
+	sources := mountSources(target, map[digest.Digest]string{
+		mounted:  "docker.io/library/alpine:latest",
+		uploaded: "alpine",
+		// different registry
+		digest.FromString("other"): "example.com/library/alpine:latest",
+		// same repository
+		digest.FromString("same"): "foo/bar:v1",
+	})
+	require.Equal(t, map[digest.Digest]string{
+		mounted:  "library/alpine",
+		uploaded: "library/alpine",
+	}, sources)
+
+	c := withMountTransport(srv.Client(), sources)
+
+	head := func(dgst digest.Digest) int {
+		req, err := http.NewRequest(http.MethodHead, srv.URL+"/v2/foo/bar/blobs/"+dgst.String(), nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer token")
+		resp, err := c.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	require.Equal(t, http.StatusOK, head(mounted))
+	require.Equal(t, http.StatusNotFound, head(uploaded))
+	require.True(t, deleted)
+	require.Equal(t, http.StatusNotFound, head(digest.FromString("unknown")))
+}
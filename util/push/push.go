@@ -17,6 +17,7 @@ import (
 	"github.com/moby/buildkit/util/imageutil"
 	"github.com/moby/buildkit/util/progress"
 	"github.com/moby/buildkit/util/resolver"
+	"github.com/moby/buildkit/util/retry"
 	digest "github.com/opencontainers/go-digest"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/sirupsen/logrus"
@@ -40,7 +41,9 @@ func getCredentialsFunc(ctx context.Context, sm *session.Manager) func(string) (
 	}
 }
 
-func Push(ctx context.Context, sm *session.Manager, cs content.Provider, dgst digest.Digest, ref string, insecure bool, rfn resolver.ResolveOptionsFunc) error {
+// Push pushes the image dgst from cs to ref. Blobs in mountFrom are mounted
+// from the image references they map to when ref is in the same registry.
+func Push(ctx context.Context, sm *session.Manager, cs content.Provider, dgst digest.Digest, ref string, insecure bool, rfn resolver.ResolveOptionsFunc, mountFrom map[digest.Digest]string) error {
 	desc := ocispec.Descriptor{
 		Digest: dgst,
 	}
@@ -55,6 +58,9 @@ func Push(ctx context.Context, sm *session.Manager, cs content.Provider, dgst di
 	if insecure {
 		opt.PlainHTTP = insecure
 	}
+	opt.Client = retry.Client(withMountTransport(opt.Client, mountSources(parsed, mountFrom)))
+	tracker := newPushTracker()
+	opt.Tracker = tracker
 
 	resolver := docker.NewResolver(opt)
 
@@ -79,7 +85,7 @@ func Push(ctx context.Context, sm *session.Manager, cs content.Provider, dgst di
 		}
 	})
 
-	pushHandler := remotes.PushHandler(pusher, cs)
+	pushHandler := retry.Handler(tracker.handler(remotes.PushHandler(pusher, cs)))
 
 	handlers := append([]images.Handler{},
 		childrenHandler(cs),
@@ -0,0 +1,56 @@
+package push
+
+import (
+	"context"
+	"sync"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// pushTracker is an in-memory docker.StatusTracker that forgets the status of
+// failed pushes. The pusher skips content whose status shows that all of it
+// was written, even if the registry then rejected the upload, so a retried
+// push would otherwise not upload it again.
+type pushTracker struct {
+	mu       sync.Mutex
+	statuses map[string]docker.Status
+}
+
+func newPushTracker() *pushTracker {
+	return &pushTracker{statuses: map[string]docker.Status{}}
+}
+
+func (t *pushTracker) GetStatus(ref string) (docker.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st, ok := t.statuses[ref]
+	if !ok {
+		return docker.Status{}, errors.Wrapf(errdefs.ErrNotFound, "status for ref %v", ref)
+	}
+	return st, nil
+}
+
+func (t *pushTracker) SetStatus(ref string, st docker.Status) {
+	t.mu.Lock()
+	t.statuses[ref] = st
+	t.mu.Unlock()
+}
+
+// handler returns a handler that runs h and forgets the status of the
+// descriptors it fails to push
+func (t *pushTracker) handler(h images.Handler) images.HandlerFunc {
+	return func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		descs, err := h.Handle(ctx, desc)
+		if err != nil {
+			t.mu.Lock()
+			delete(t.statuses, remotes.MakeRefKey(ctx, desc))
+			t.mu.Unlock()
+		}
+		return descs, err
+	}
+}
@@ -0,0 +1,82 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/moby/buildkit/util/contentutil"
+	"github.com/moby/buildkit/util/retry"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushRetry(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var posts, puts int
+	var pushed []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/foo/bar/blobs/uploads/":
+			posts++
+			w.Header().Set("Location", fmt.Sprintf("/v2/foo/bar/blobs/uploads/%d", posts))
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPut:
+			puts++
+			dt, err := ioutil.ReadAll(r.Body)
+			if err != nil || puts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			pushed = dt
+			w.Header().Set("Docker-Content-Digest", r.URL.Query().Get("digest"))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.TODO()
+	dt := []byte("foobar")
+	desc := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageLayer,
+		Digest:    digest.FromBytes(dt),
+		Size:      int64(len(dt)),
+	}
+	buf := contentutil.NewBuffer()
+	err := content.WriteBlob(ctx, buf, "foo", bytes.NewReader(dt), desc)
+	require.NoError(t, err)
+
+	tracker := newPushTracker()
+	resolver := docker.NewResolver(docker.ResolverOptions{
+		PlainHTTP: true,
+		Client:    retry.Client(nil),
+		Tracker:   tracker,
+	})
+	pusher, err := resolver.Pusher(ctx, strings.TrimPrefix(srv.URL, "http://")+"/foo/bar:latest")
+	require.NoError(t, err)
+
+	// the upload fails once with a 503 after all of the blob was written
+	_, err = retry.Handler(tracker.handler(remotes.PushHandler(pusher, buf)))(ctx, desc)
+	require.NoError(t, err)
+	require.Equal(t, 2, posts)
+	require.Equal(t, 2, puts)
+	require.Equal(t, dt, pushed)
+}
@@ -0,0 +1,199 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/moby/buildkit/util/progress"
+)
+
+// Config controls how often and for how long an operation is retried
+type Config struct {
+	// Attempts is the maximum number of times the operation is run
+	Attempts int
+	// InitialBackoff is the wait before the first retry. It doubles on every
+	// following retry up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Budget is the maximum total time spent waiting between attempts. An
+	// operation is not retried if the next wait would exceed the budget.
+	Budget time.Duration
+}
+
+// Default is the configuration used by Do, Transport and Handler
+var Default = Config{
+	Attempts:       5,
+	InitialBackoff: time.Second,
+	MaxBackoff:     10 * time.Second,
+	Budget:         time.Minute,
+}
+
+// Do runs fn until it succeeds or returns an error that is not retryable,
+// using the default configuration. name identifies the operation in the
+// progress stream of ctx.
+func Do(ctx context.Context, name string, fn func() error) error {
+	return Default.Do(ctx, name, fn)
+}
+
+// Do runs fn until it succeeds, returns an error that is not retryable or
+// the attempts or budget of c run out. Every retry is written to the
+// progress stream of ctx.
+func (c Config) Do(ctx context.Context, name string, fn func() error) error {
+	backoff := c.InitialBackoff
+	var waited time.Duration
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		ok, after := IsRetryable(err)
+		if !ok || attempt >= c.Attempts || ctx.Err() != nil {
+			return err
+		}
+
+		wait := backoff
+		if backoff > 0 {
+			// jitter avoids concurrent builds retrying in lockstep
+			wait += time.Duration(rand.Int63n(int64(backoff)/5 + 1))
+		}
+		if after > wait {
+			wait = after
+		}
+		if waited+wait > c.Budget {
+			return err
+		}
+		waited += wait
+
+		if err := sleep(ctx, fmt.Sprintf("%s: retrying in %s (attempt %d/%d) after error: %v", name, wait.Round(time.Millisecond), attempt+1, c.Attempts, err), wait); err != nil {
+			return err
+		}
+
+		backoff *= 2
+		if backoff > c.MaxBackoff {
+			backoff = c.MaxBackoff
+		}
+	}
+}
+
+func sleep(ctx context.Context, id string, d time.Duration) error {
+	pw, _, _ := progress.FromContext(ctx)
+	defer pw.Close()
+	now := time.Now()
+	st := progress.Status{
+		Started: &now,
+	}
+	pw.Write(id, st)
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	now = time.Now()
+	st.Completed = &now
+	pw.Write(id, st)
+	return nil
+}
+
+type retryableError struct {
+	error
+	after time.Duration
+}
+
+func (e *retryableError) Cause() error {
+	return e.error
+}
+
+// Retryable marks err as a transient failure that can be retried
+func Retryable(err error) error {
+	return RetryAfter(err, 0)
+}
+
+// RetryAfter marks err as a transient failure that can be retried after d
+func RetryAfter(err error, d time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{error: err, after: d}
+}
+
+// IsRetryable returns true if err is a transient failure, like a reset
+// connection or a response with a 5xx or 429 status code. The duration is
+// the minimum wait before retrying requested by the server.
+func IsRetryable(err error) (bool, time.Duration) {
+	for err != nil {
+		switch e := err.(type) {
+		case *retryableError:
+			return true, e.after
+		case *finalError:
+			return false, 0
+		case *StatusError:
+			return e.retryable(), e.RetryAfter
+		case *url.Error:
+			err = e.Err
+			continue
+		case *net.OpError:
+			if e.Timeout() {
+				return true, 0
+			}
+			err = e.Err
+			continue
+		case *os.SyscallError:
+			err = e.Err
+			continue
+		case syscall.Errno:
+			switch e {
+			case syscall.ECONNRESET, syscall.ECONNREFUSED, syscall.ECONNABORTED, syscall.ETIMEDOUT, syscall.EPIPE:
+				return true, 0
+			}
+			return false, 0
+		}
+		if err == io.ErrUnexpectedEOF {
+			return true, 0
+		}
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false, 0
+		}
+		if ne, ok := err.(net.Error); ok && ne.Timeout() {
+			return true, 0
+		}
+		c, ok := err.(interface {
+			Cause() error
+		})
+		if !ok {
+			break
+		}
+		err = c.Cause()
+	}
+	return false, 0
+}
+
+// StatusError is returned for HTTP responses with an unexpected status code
+type StatusError struct {
+	StatusCode int
+	Status     string
+	// RetryAfter is the wait requested by the Retry-After header
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status: %s", e.Status)
+}
+
+func (e *StatusError) retryable() bool {
+	switch e.StatusCode {
+	case 429, 500, 502, 503, 504:
+		return true
+	}
+	return false
+}
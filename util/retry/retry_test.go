@@ -0,0 +1,232 @@
+package retry
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/images"
+	"github.com/moby/buildkit/util/progress"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+var testConfig = Config{
+	Attempts:       3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+	Budget:         time.Second,
+}
+
+func TestDo(t *testing.T) {
+	t.Parallel()
+
+	pr, ctx, cancel := progress.NewContext(context.TODO())
+
+	var calls int
+	err := testConfig.Do(ctx, "foo", func() error {
+		calls++
+		if calls < 3 {
+			return errors.Wrap(Retryable(errors.New("flaky")), "failed")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+	cancel()
+
+	ids := map[string]struct{}{}
+	for {
+		p, err := pr.Read(context.TODO())
+		if err != nil {
+			break
+		}
+		for _, pp := range p {
+			ids[pp.ID] = struct{}{}
+		}
+	}
+	require.Equal(t, 2, len(ids))
+
+	// attempts run out
+	calls = 0
+	err = testConfig.Do(context.TODO(), "foo", func() error {
+		calls++
+		return Retryable(errors.New("flaky"))
+	})
+	require.Error(t, err)
+	require.Equal(t, "flaky", err.Error())
+	require.Equal(t, 3, calls)
+
+	// not retryable
+	calls = 0
+	err = testConfig.Do(context.TODO(), "foo", func() error {
+		calls++
+		return errors.New("permanent")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+
+	// wait requested by the server exceeds the budget
+	calls = 0
+	err = testConfig.Do(context.TODO(), "foo", func() error {
+		calls++
+		return RetryAfter(errors.New("slow down"), time.Minute)
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		err       error
+		retryable bool
+	}{
+		{errors.New("foo"), false},
+		{context.Canceled, false},
+		{errors.Wrap(context.DeadlineExceeded, "foo"), false},
+		{errors.Wrap(Retryable(errors.New("foo")), "bar"), true},
+		{&StatusError{StatusCode: 503}, true},
+		{&StatusError{StatusCode: 429}, true},
+		{&StatusError{StatusCode: 404}, false},
+		{errors.Wrap(&net.OpError{Op: "read", Err: &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}}, "foo"), true},
+		{syscall.ENOENT, false},
+	} {
+		ok, _ := IsRetryable(tc.err)
+		require.Equal(t, tc.retryable, ok, "%v", tc.err)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch r.URL.Path {
+		case "/flaky":
+			if calls == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte("ok"))
+		case "/down":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := &http.Client{Transport: &transport{rt: http.DefaultTransport, cfg: testConfig}}
+
+	resp, err := c.Get(server.URL + "/flaky")
+	require.NoError(t, err)
+	dt, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(dt))
+	require.Equal(t, 2, calls)
+
+	// the last response is returned when all attempts fail
+	calls = 0
+	resp, err = c.Get(server.URL + "/down")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, 3, calls)
+
+	calls = 0
+	resp, err = c.Get(server.URL + "/notfound")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.Equal(t, 1, calls)
+
+	// POST requests are not retried
+	calls = 0
+	resp, err = c.Post(server.URL+"/down", "text/plain", strings.NewReader("foo"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, 1, calls)
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := &http.Client{Transport: &transport{rt: http.DefaultTransport, cfg: testConfig}}
+	h := images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		resp, err := ctxhttp.Post(ctx, c, server.URL, "text/plain", strings.NewReader("foo"))
+		if err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			return nil, errors.Errorf("unexpected status: %s", resp.Status)
+		}
+		return nil, nil
+	})
+
+	// the POST request is not retried by the transport, so the handler
+	// retries it
+	_, err := testConfig.Handler(h)(context.TODO(), ocispec.Descriptor{})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func TestHandlerRetried(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := &http.Client{Transport: &transport{rt: http.DefaultTransport, cfg: testConfig}}
+	h := images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		resp, err := ctxhttp.Get(ctx, c, server.URL)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+		return nil, errors.Errorf("unexpected status: %s", resp.Status)
+	})
+
+	// requests already retried by the transport are not retried again
+	_, err := testConfig.Handler(h)(context.TODO(), ocispec.Descriptor{})
+	require.Error(t, err)
+	require.Equal(t, testConfig.Attempts, calls)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 3*time.Second, parseRetryAfter("3"))
+	require.Equal(t, time.Duration(0), parseRetryAfter("foo"))
+	d := parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	require.True(t, d > 59*time.Minute && d <= time.Hour, "%v", d)
+}
@@ -0,0 +1,220 @@
+package retry
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/images"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// Transport returns a RoundTripper that retries GET, HEAD and PUT requests to
+// rt that fail with a transient error or a 5xx or 429 status code. Requests
+// with other methods, and requests with a body that can't be read again, are
+// not retried. If all attempts fail because of the status code, the last
+// response is returned.
+func Transport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	if _, ok := rt.(*transport); ok {
+		return rt
+	}
+	return &transport{rt: rt, cfg: Default}
+}
+
+// Client returns a copy of c that retries requests with Transport
+func Client(c *http.Client) *http.Client {
+	if c == nil {
+		c = http.DefaultClient
+	}
+	nc := *c
+	nc.Transport = Transport(c.Transport)
+	return &nc
+}
+
+// retryMethods are the methods of requests that are retried by Transport.
+// They are idempotent, unlike POST requests that for example start a new
+// blob upload.
+var retryMethods = map[string]bool{
+	http.MethodGet:  true,
+	http.MethodHead: true,
+	http.MethodPut:  true,
+}
+
+type transport struct {
+	rt  http.RoundTripper
+	cfg Config
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	a, _ := req.Context().Value(attemptKey{}).(*attempt)
+	if !retryMethods[req.Method] || req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		resp, err := t.rt.RoundTrip(req)
+		if a != nil {
+			if err != nil {
+				a.fail(err)
+			} else if se := newStatusError(resp); se != nil {
+				a.fail(se)
+			}
+		}
+		return resp, err
+	}
+
+	var resp *http.Response
+	first := true
+	err := t.cfg.Do(req.Context(), req.Method+" "+req.URL.Host+req.URL.Path, func() error {
+		r := req
+		if !first && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return err
+			}
+			r = new(http.Request)
+			*r = *req
+			r.Body = body
+		}
+		first = false
+
+		if resp != nil {
+			discard(resp)
+			resp = nil
+		}
+		var err error
+		resp, err = t.rt.RoundTrip(r)
+		if err != nil {
+			return err
+		}
+		if se := newStatusError(resp); se != nil && se.retryable() {
+			return se
+		}
+		return nil
+	})
+	if a != nil && err != nil {
+		if ok, _ := IsRetryable(err); ok {
+			a.exhausted()
+		}
+	}
+	if _, ok := err.(*StatusError); ok && resp != nil {
+		return resp, nil
+	}
+	if err != nil && resp != nil {
+		discard(resp)
+		resp = nil
+	}
+	return resp, err
+}
+
+func newStatusError(resp *http.Response) *StatusError {
+	if resp.StatusCode < 400 {
+		return nil
+	}
+	return &StatusError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+}
+
+// parseRetryAfter parses the value of a Retry-After header, either a number
+// of seconds or a date
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if s, err := strconv.Atoi(v); err == nil && s > 0 {
+		return time.Duration(s) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+func discard(resp *http.Response) {
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+}
+
+// Handler returns a handler that retries h when it fails with a transient
+// error, for example when a connection is reset while a blob is fetched or
+// when the registry fails a blob upload that Transport can't retry. Failures
+// of requests that Transport already retried are not retried again.
+func Handler(h images.Handler) images.HandlerFunc {
+	return Default.Handler(h)
+}
+
+// Handler returns a handler that retries h with the configuration c
+func (c Config) Handler(h images.Handler) images.HandlerFunc {
+	return func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		var descs []ocispec.Descriptor
+		err := c.Do(ctx, desc.Digest.String(), func() error {
+			a := &attempt{}
+			var err error
+			descs, err = h.Handle(context.WithValue(ctx, attemptKey{}, a), desc)
+			return a.result(err)
+		})
+		return descs, err
+	}
+}
+
+type attemptKey struct{}
+
+// attempt records the failed requests of one attempt of Handler
+type attempt struct {
+	mu sync.Mutex
+	// retried is set when Transport gave up retrying a request
+	retried bool
+	// err is the last transient failure of a request that Transport did
+	// not retry
+	err error
+}
+
+func (a *attempt) fail(err error) {
+	if ok, _ := IsRetryable(err); !ok {
+		return
+	}
+	a.mu.Lock()
+	a.err = err
+	a.mu.Unlock()
+}
+
+func (a *attempt) exhausted() {
+	a.mu.Lock()
+	a.retried = true
+	a.mu.Unlock()
+}
+
+// result returns the error of the attempt, marked as retryable if one of its
+// requests failed with a transient error that Transport did not retry
+func (a *attempt) result(err error) error {
+	if err == nil {
+		return nil
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.retried {
+		return &finalError{err}
+	}
+	if a.err != nil {
+		_, after := IsRetryable(a.err)
+		return RetryAfter(err, after)
+	}
+	return err
+}
+
+// finalError is an error that is not retried again
+type finalError struct {
+	error
+}
+
+func (e *finalError) Cause() error {
+	return e.error
+}
@@ -0,0 +1,261 @@
+package sbom
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// buildInfoAlign is the alignment of the build information in the data of
+	// a Go binary
+	buildInfoAlign = 16
+	// buildInfoHeaderSize is the size of the header of the build information
+	buildInfoHeaderSize = 32
+	// maxBuildInfoSearch is how much data of a binary is searched for the
+	// build information
+	maxBuildInfoSearch = 64 << 10
+	// maxModInfoSize is the size of the largest module information that is
+	// read from a binary
+	maxModInfoSize = 4 << 20
+
+	// PE section flags of initialized, writable data
+	peInitializedData = 0x40
+	peMemWrite        = 0x80000000
+)
+
+// buildInfoMagic starts the build information that the Go linker writes to
+// binaries, see debug/buildinfo
+var buildInfoMagic = []byte("\xff Go buildinf:")
+
+// exe is an executable that Go build information can be read from
+type exe interface {
+	// readData returns up to size bytes at the virtual address addr
+	readData(addr, size uint64) ([]byte, error)
+	// buildInfoData returns the address and contents of the data that the
+	// build information is searched for in
+	buildInfoData() (uint64, []byte, error)
+}
+
+// readModInfo returns the module information of the Go binary r. Only the
+// build information section of the binary is read. It returns nil if r is
+// not a Go binary.
+func readModInfo(r io.ReaderAt) ([]byte, error) {
+	magic := make([]byte, 4)
+	if _, err := r.ReadAt(magic, 0); err != nil {
+		return nil, nil
+	}
+
+	var x exe
+	switch {
+	case bytes.HasPrefix(magic, []byte("\x7fELF")):
+		f, err := elf.NewFile(r)
+		if err != nil {
+			return nil, nil
+		}
+		x = &elfExe{f}
+	case bytes.HasPrefix(magic, []byte("MZ")):
+		f, err := pe.NewFile(r)
+		if err != nil {
+			return nil, nil
+		}
+		x = &peExe{f}
+	case bytes.HasPrefix(magic, []byte("\xfe\xed\xfa")) || bytes.HasPrefix(magic[1:], []byte("\xfa\xed\xfe")):
+		f, err := macho.NewFile(r)
+		if err != nil {
+			return nil, nil
+		}
+		x = &machoExe{f}
+	default:
+		return nil, nil
+	}
+
+	addr, data, err := x.buildInfoData()
+	if err != nil || data == nil {
+		return nil, nil
+	}
+	for {
+		i := bytes.Index(data, buildInfoMagic)
+		if i < 0 || len(data)-i < buildInfoHeaderSize {
+			return nil, nil
+		}
+		if i%buildInfoAlign == 0 {
+			addr += uint64(i)
+			data = data[i:]
+			break
+		}
+		next := (i + buildInfoAlign - 1) &^ (buildInfoAlign - 1)
+		addr += uint64(next)
+		data = data[next:]
+	}
+
+	ptrSize := int(data[14])
+	flags := data[15]
+	if flags&2 != 0 {
+		// the strings follow the header
+		data = data[buildInfoHeaderSize:]
+		_, data = readVarintString(data)
+		mod, _ := readVarintString(data)
+		return mod, nil
+	}
+
+	if ptrSize != 4 && ptrSize != 8 {
+		return nil, nil
+	}
+	var bo binary.ByteOrder = binary.LittleEndian
+	if flags&1 != 0 {
+		bo = binary.BigEndian
+	}
+	readPtr := func(b []byte) uint64 {
+		if ptrSize == 4 {
+			return uint64(bo.Uint32(b))
+		}
+		return bo.Uint64(b)
+	}
+
+	// the header points to the string headers of the version and the module
+	// information
+	hdr, err := x.readData(readPtr(data[16+ptrSize:]), uint64(2*ptrSize))
+	if err != nil || len(hdr) < 2*ptrSize {
+		return nil, nil
+	}
+	size := readPtr(hdr[ptrSize:])
+	if size > maxModInfoSize {
+		return nil, errors.Errorf("module information of %d bytes is too large", size)
+	}
+	mod, err := x.readData(readPtr(hdr), size)
+	if err != nil || uint64(len(mod)) < size {
+		return nil, nil
+	}
+	return mod, nil
+}
+
+// readVarintString returns the string prefixed with its varint encoded length
+// at the start of dt and the data following it
+func readVarintString(dt []byte) ([]byte, []byte) {
+	n, i := binary.Uvarint(dt)
+	if i <= 0 || n > uint64(len(dt)-i) {
+		return nil, nil
+	}
+	return dt[i : i+int(n)], dt[i+int(n):]
+}
+
+// readAtMost reads up to size bytes from the start of r
+func readAtMost(r io.ReaderAt, size uint64) ([]byte, error) {
+	if size > maxBuildInfoSearch {
+		size = maxBuildInfoSearch
+	}
+	return ioutil.ReadAll(io.NewSectionReader(r, 0, int64(size)))
+}
+
+type elfExe struct {
+	f *elf.File
+}
+
+func (x *elfExe) readData(addr, size uint64) ([]byte, error) {
+	for _, p := range x.f.Progs {
+		if p.Type == elf.PT_LOAD && p.Vaddr <= addr && addr-p.Vaddr < p.Filesz {
+			if n := p.Vaddr + p.Filesz - addr; n < size {
+				size = n
+			}
+			dt := make([]byte, size)
+			_, err := p.ReadAt(dt, int64(addr-p.Vaddr))
+			return dt, err
+		}
+	}
+	return nil, errors.Errorf("address %#x is not in a segment", addr)
+}
+
+func (x *elfExe) buildInfoData() (uint64, []byte, error) {
+	if s := x.f.Section(".go.buildinfo"); s != nil && s.Type != elf.SHT_NOBITS {
+		dt, err := readAtMost(s, s.Size)
+		return s.Addr, dt, err
+	}
+	for _, p := range x.f.Progs {
+		if p.Type == elf.PT_LOAD && p.Flags&(elf.PF_X|elf.PF_W) == elf.PF_W {
+			dt, err := readAtMost(p, p.Filesz)
+			return p.Vaddr, dt, err
+		}
+	}
+	return 0, nil, nil
+}
+
+type peExe struct {
+	f *pe.File
+}
+
+func (x *peExe) imageBase() uint64 {
+	switch oh := x.f.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		return uint64(oh.ImageBase)
+	case *pe.OptionalHeader64:
+		return oh.ImageBase
+	}
+	return 0
+}
+
+func (x *peExe) readData(addr, size uint64) ([]byte, error) {
+	addr -= x.imageBase()
+	for _, s := range x.f.Sections {
+		start := uint64(s.VirtualAddress)
+		if start <= addr && addr-start < uint64(s.Size) {
+			if n := start + uint64(s.Size) - addr; n < size {
+				size = n
+			}
+			dt := make([]byte, size)
+			_, err := s.ReadAt(dt, int64(addr-start))
+			return dt, err
+		}
+	}
+	return nil, errors.Errorf("address %#x is not in a section", addr)
+}
+
+func (x *peExe) buildInfoData() (uint64, []byte, error) {
+	for _, s := range x.f.Sections {
+		if s.VirtualAddress != 0 && s.Characteristics&peInitializedData != 0 && s.Characteristics&peMemWrite != 0 {
+			dt, err := readAtMost(s, uint64(s.Size))
+			return x.imageBase() + uint64(s.VirtualAddress), dt, err
+		}
+	}
+	return 0, nil, nil
+}
+
+type machoExe struct {
+	f *macho.File
+}
+
+func (x *machoExe) readData(addr, size uint64) ([]byte, error) {
+	for _, l := range x.f.Loads {
+		seg, ok := l.(*macho.Segment)
+		if !ok || seg.Name == "__PAGEZERO" {
+			continue
+		}
+		if seg.Addr <= addr && addr-seg.Addr < seg.Filesz {
+			if n := seg.Addr + seg.Filesz - addr; n < size {
+				size = n
+			}
+			dt := make([]byte, size)
+			_, err := seg.ReadAt(dt, int64(addr-seg.Addr))
+			return dt, err
+		}
+	}
+	return nil, errors.Errorf("address %#x is not in a segment", addr)
+}
+
+func (x *machoExe) buildInfoData() (uint64, []byte, error) {
+	if s := x.f.Section("__go_buildinfo"); s != nil {
+		dt, err := readAtMost(s, s.Size)
+		return s.Addr, dt, err
+	}
+	if seg := x.f.Segment("__DATA"); seg != nil {
+		dt, err := readAtMost(seg, seg.Filesz)
+		return seg.Addr, dt, err
+	}
+	return 0, nil, nil
+}
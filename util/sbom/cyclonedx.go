@@ -0,0 +1,116 @@
+package sbom
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MediaType is the media type of the documents returned by CycloneDX
+const MediaType = "application/vnd.cyclonedx+json"
+
+type cdxDocument struct {
+	BOMFormat   string         `json:"bomFormat"`
+	SpecVersion string         `json:"specVersion"`
+	Version     int            `json:"version"`
+	Metadata    cdxMetadata    `json:"metadata"`
+	Components  []cdxComponent `json:"components"`
+}
+
+type cdxMetadata struct {
+	Tools []cdxTool `json:"tools"`
+}
+
+type cdxTool struct {
+	Vendor string `json:"vendor"`
+	Name   string `json:"name"`
+}
+
+type cdxComponent struct {
+	BOMRef     string        `json:"bom-ref"`
+	Type       string        `json:"type"`
+	Name       string        `json:"name"`
+	Version    string        `json:"version,omitempty"`
+	PURL       string        `json:"purl"`
+	Properties []cdxProperty `json:"properties,omitempty"`
+}
+
+type cdxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CycloneDX returns a CycloneDX JSON document listing pkgs. The document
+// does not contain timestamps so that it is reproducible.
+func CycloneDX(pkgs []Package) ([]byte, error) {
+	doc := cdxDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.4",
+		Version:     1,
+		Metadata: cdxMetadata{
+			Tools: []cdxTool{{Vendor: "moby", Name: "buildkit"}},
+		},
+		Components: []cdxComponent{},
+	}
+	seen := map[string]struct{}{}
+	for _, p := range pkgs {
+		purl := PURL(p)
+		ref := purl + "#" + p.Location
+		if _, ok := seen[ref]; ok {
+			continue
+		}
+		seen[ref] = struct{}{}
+		doc.Components = append(doc.Components, cdxComponent{
+			BOMRef:  ref,
+			Type:    "library",
+			Name:    p.Name,
+			Version: p.Version,
+			PURL:    purl,
+			Properties: []cdxProperty{
+				{Name: "buildkit:location", Value: p.Location},
+			},
+		})
+	}
+	dt, err := json.MarshalIndent(doc, "", "  ")
+	return dt, errors.Wrap(err, "failed to marshal sbom")
+}
+
+// PURL returns the package URL of p
+func PURL(p Package) string {
+	var namespace string
+	switch p.Type {
+	case TypeDeb, TypeApk:
+		namespace = p.Distro
+	case TypeGolang:
+		if i := strings.LastIndex(p.Name, "/"); i != -1 {
+			namespace = p.Name[:i]
+		}
+	}
+	name := p.Name
+	if namespace != "" && strings.HasPrefix(name, namespace+"/") {
+		name = strings.TrimPrefix(name, namespace+"/")
+	}
+
+	s := "pkg:" + p.Type + "/"
+	if namespace != "" {
+		var segs []string
+		for _, seg := range strings.Split(namespace, "/") {
+			segs = append(segs, escapePURL(seg))
+		}
+		s += strings.Join(segs, "/") + "/"
+	}
+	s += escapePURL(name)
+	if p.Version != "" {
+		s += "@" + escapePURL(p.Version)
+	}
+	if p.Arch != "" {
+		s += "?arch=" + url.QueryEscape(p.Arch)
+	}
+	return s
+}
+
+func escapePURL(s string) string {
+	return strings.Replace(url.PathEscape(s), ":", "%3A", -1)
+}
@@ -0,0 +1,101 @@
+package sbom
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containerd/continuity/fs"
+)
+
+var (
+	// the module information of a Go binary is wrapped by these markers, see
+	// runtime/debug.modinfo
+	modInfoStart = []byte("0w\xaf\x0c\x92t\x08\x02A\xe1\xc1\x07\xe6\xd6\x18\xe6")
+	modInfoEnd   = []byte("\xf92C1\x86\x18 r\x00\x82B\x10A\x16\xd8\xf2")
+)
+
+func scanGoBinaries(ctx context.Context, root string) ([]Package, error) {
+	var pkgs []Package
+	err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) || os.IsPermission(err) {
+				return nil
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() || fi.Mode()&0111 == 0 {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		dt, err := readBinary(root, rel)
+		if err != nil || dt == nil {
+			return nil
+		}
+		pkgs = append(pkgs, parseModInfo(dt, "/"+filepath.ToSlash(rel))...)
+		return nil
+	})
+	return pkgs, err
+}
+
+// readBinary returns the module information of the Go binary at p in root
+func readBinary(root, p string) ([]byte, error) {
+	p, err := fs.RootPath(root, p)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readModInfo(f)
+}
+
+// parseModInfo returns the Go modules recorded in the binary dt
+func parseModInfo(dt []byte, location string) []Package {
+	i := bytes.Index(dt, modInfoStart)
+	if i == -1 {
+		return nil
+	}
+	dt = dt[i+len(modInfoStart):]
+	i = bytes.Index(dt, modInfoEnd)
+	if i == -1 {
+		return nil
+	}
+
+	var pkgs []Package
+	for _, l := range strings.Split(string(dt[:i]), "\n") {
+		f := strings.Split(l, "\t")
+		if len(f) < 3 {
+			continue
+		}
+		pkg := Package{
+			Type:     TypeGolang,
+			Name:     f[1],
+			Version:  f[2],
+			Location: location,
+		}
+		if pkg.Version == "(devel)" {
+			pkg.Version = ""
+		}
+		switch f[0] {
+		case "mod", "dep":
+			pkgs = append(pkgs, pkg)
+		case "=>":
+			// replaces the previous dependency
+			if len(pkgs) > 0 {
+				pkgs[len(pkgs)-1] = pkg
+			}
+		}
+	}
+	return pkgs
+}
@@ -0,0 +1,44 @@
+package sbom
+
+import "strings"
+
+const (
+	dpkgStatus   = "var/lib/dpkg/status"
+	apkInstalled = "lib/apk/db/installed"
+)
+
+func scanDpkg(root, distro string) ([]Package, error) {
+	var pkgs []Package
+	err := readParagraphs(root, dpkgStatus, ":", func(m map[string]string) {
+		if m["Package"] == "" || !strings.HasSuffix(m["Status"], " installed") {
+			return
+		}
+		pkgs = append(pkgs, Package{
+			Type:     TypeDeb,
+			Name:     m["Package"],
+			Version:  m["Version"],
+			Arch:     m["Architecture"],
+			Distro:   distro,
+			Location: "/" + dpkgStatus,
+		})
+	})
+	return pkgs, err
+}
+
+func scanApk(root, distro string) ([]Package, error) {
+	var pkgs []Package
+	err := readParagraphs(root, apkInstalled, ":", func(m map[string]string) {
+		if m["P"] == "" {
+			return
+		}
+		pkgs = append(pkgs, Package{
+			Type:     TypeApk,
+			Name:     m["P"],
+			Version:  m["V"],
+			Arch:     m["A"],
+			Distro:   distro,
+			Location: "/" + apkInstalled,
+		})
+	})
+	return pkgs, err
+}
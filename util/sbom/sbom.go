@@ -0,0 +1,175 @@
+// Package sbom creates software bills of materials for the filesystems of
+// build results. Packages are found from the dpkg and apk databases and from
+// the module information embedded in Go binaries. RPM databases are not
+// supported.
+package sbom
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/containerd/continuity/fs"
+	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/snapshot"
+	"github.com/pkg/errors"
+)
+
+// Package types
+const (
+	TypeDeb    = "deb"
+	TypeApk    = "apk"
+	TypeGolang = "golang"
+)
+
+// Package is a software package found in a filesystem
+type Package struct {
+	Type    string
+	Name    string
+	Version string
+	Arch    string
+	// Distro is the ID of the distribution from /etc/os-release
+	Distro string
+	// Location is the path of the file the package was found from
+	Location string
+}
+
+// Generate returns a CycloneDX document for the packages in ref
+func Generate(ctx context.Context, ref cache.ImmutableRef) ([]byte, error) {
+	if ref == nil {
+		return CycloneDX(nil)
+	}
+	mount, err := ref.Mount(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	lm := snapshot.LocalMounter(mount)
+	root, err := lm.Mount()
+	if err != nil {
+		return nil, err
+	}
+	defer lm.Unmount()
+
+	pkgs, err := Scan(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan for packages")
+	}
+	return CycloneDX(pkgs)
+}
+
+// Scan returns the packages installed in the filesystem at root
+func Scan(ctx context.Context, root string) ([]Package, error) {
+	distro, err := readDistro(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var pkgs []Package
+	for _, scan := range []func(string, string) ([]Package, error){scanDpkg, scanApk} {
+		p, err := scan(root, distro)
+		if err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, p...)
+	}
+
+	p, err := scanGoBinaries(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	pkgs = append(pkgs, p...)
+
+	sort.SliceStable(pkgs, func(i, j int) bool {
+		if pkgs[i].Type != pkgs[j].Type {
+			return pkgs[i].Type < pkgs[j].Type
+		}
+		if pkgs[i].Name != pkgs[j].Name {
+			return pkgs[i].Name < pkgs[j].Name
+		}
+		if pkgs[i].Version != pkgs[j].Version {
+			return pkgs[i].Version < pkgs[j].Version
+		}
+		return pkgs[i].Location < pkgs[j].Location
+	})
+	return pkgs, nil
+}
+
+// readDistro returns the ID of the distribution of the filesystem
+func readDistro(root string) (string, error) {
+	for _, p := range []string{"/etc/os-release", "/usr/lib/os-release"} {
+		p, err := fs.RootPath(root, p)
+		if err != nil {
+			return "", err
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", err
+		}
+		defer f.Close()
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			if v := strings.TrimPrefix(s.Text(), "ID="); v != s.Text() {
+				return strings.Trim(v, `"'`), nil
+			}
+		}
+		return "", s.Err()
+	}
+	return "", nil
+}
+
+// readParagraphs parses the file at p in root with key-value paragraphs
+// separated by empty lines. Lines starting with whitespace continue the
+// previous value.
+func readParagraphs(root, p, sep string, fn func(map[string]string)) error {
+	fp, err := fs.RootPath(root, p)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(fp)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	m := map[string]string{}
+	s := bufio.NewScanner(f)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	var last string
+	for s.Scan() {
+		l := s.Text()
+		if strings.TrimSpace(l) == "" {
+			if len(m) > 0 {
+				fn(m)
+				m = map[string]string{}
+			}
+			continue
+		}
+		if l[0] == ' ' || l[0] == '\t' {
+			if last != "" {
+				m[last] += "\n" + strings.TrimSpace(l)
+			}
+			continue
+		}
+		parts := strings.SplitN(l, sep, 2)
+		if len(parts) != 2 {
+			continue
+		}
+		last = parts[0]
+		m[last] = strings.TrimSpace(parts[1])
+	}
+	if err := s.Err(); err != nil {
+		return errors.Wrapf(err, "failed to read %s", p)
+	}
+	if len(m) > 0 {
+		fn(m)
+	}
+	return nil
+}
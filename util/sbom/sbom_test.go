@@ -0,0 +1,199 @@
+package sbom
+
+import (
+	"bytes"
+	"context"
+	"debug/elf"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/continuity/fs/fstest"
+	"github.com/stretchr/testify/require"
+)
+
+const dpkgStatusData = `Package: bash
+Status: install ok installed
+Priority: required
+Architecture: amd64
+Version: 5.0-4
+Description: GNU Bourne Again SHell
+ Bash is an sh-compatible command language interpreter.
+
+Package: removed
+Status: deinstall ok config-files
+Architecture: amd64
+Version: 1.0
+
+Package: libc6
+Status: install ok installed
+Architecture: amd64
+Version: 2:2.28-10
+`
+
+const apkInstalledData = `C:Q1abc=
+P:musl
+V:1.1.24-r2
+A:x86_64
+
+C:Q1def=
+P:busybox
+V:1.31.1-r9
+A:x86_64
+`
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	modInfo := "path\texample.com/cmd/foo\n" +
+		"mod\texample.com/cmd\tv1.2.0\th1:abc=\n" +
+		"dep\tgithub.com/pkg/errors\tv0.8.0\th1:def=\n" +
+		"dep\tgolang.org/x/sync\tv0.1.0\th1:ghi=\n" +
+		"=>\tgolang.org/x/sync\tv0.2.0\th1:jkl=\n"
+	binary := goBinary(string(modInfoStart) + modInfo + string(modInfoEnd))
+
+	dir, err := tmpdir(
+		fstest.CreateDir("etc", 0755),
+		fstest.CreateFile("etc/os-release", []byte("NAME=\"Debian GNU/Linux\"\nID=debian\n"), 0644),
+		fstest.CreateDir("var", 0755),
+		fstest.CreateDir("var/lib", 0755),
+		fstest.CreateDir("var/lib/dpkg", 0755),
+		fstest.CreateFile("var/lib/dpkg/status", []byte(dpkgStatusData), 0644),
+		fstest.CreateDir("lib", 0755),
+		fstest.CreateDir("lib/apk", 0755),
+		fstest.CreateDir("lib/apk/db", 0755),
+		fstest.CreateFile("lib/apk/db/installed", []byte(apkInstalledData), 0644),
+		fstest.CreateDir("bin", 0755),
+		fstest.CreateFile("bin/foo", binary, 0755),
+		// not executable
+		fstest.CreateFile("bin/bar", binary, 0644),
+		// module information outside of the build information section
+		fstest.CreateFile("bin/baz", []byte("\x7fELF\x02\x01\x01"+string(modInfoStart)+modInfo+string(modInfoEnd)), 0755),
+	)
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pkgs, err := Scan(context.TODO(), dir)
+	require.NoError(t, err)
+
+	require.Equal(t, []Package{
+		{Type: TypeApk, Name: "busybox", Version: "1.31.1-r9", Arch: "x86_64", Distro: "debian", Location: "/lib/apk/db/installed"},
+		{Type: TypeApk, Name: "musl", Version: "1.1.24-r2", Arch: "x86_64", Distro: "debian", Location: "/lib/apk/db/installed"},
+		{Type: TypeDeb, Name: "bash", Version: "5.0-4", Arch: "amd64", Distro: "debian", Location: "/var/lib/dpkg/status"},
+		{Type: TypeDeb, Name: "libc6", Version: "2:2.28-10", Arch: "amd64", Distro: "debian", Location: "/var/lib/dpkg/status"},
+		{Type: TypeGolang, Name: "example.com/cmd", Version: "v1.2.0", Location: "/bin/foo"},
+		{Type: TypeGolang, Name: "github.com/pkg/errors", Version: "v0.8.0", Location: "/bin/foo"},
+		{Type: TypeGolang, Name: "golang.org/x/sync", Version: "v0.2.0", Location: "/bin/foo"},
+	}, pkgs)
+
+	dt, err := CycloneDX(pkgs)
+	require.NoError(t, err)
+
+	var doc struct {
+		BOMFormat  string `json:"bomFormat"`
+		Components []struct {
+			Name string `json:"name"`
+			PURL string `json:"purl"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(dt, &doc))
+	require.Equal(t, "CycloneDX", doc.BOMFormat)
+	require.Equal(t, len(pkgs), len(doc.Components))
+	require.Equal(t, "pkg:deb/debian/libc6@2%3A2.28-10?arch=amd64", doc.Components[3].PURL)
+	require.Equal(t, "pkg:golang/github.com/pkg/errors@v0.8.0", doc.Components[5].PURL)
+}
+
+func TestScanSymlinks(t *testing.T) {
+	t.Parallel()
+
+	host, err := tmpdir(
+		fstest.CreateFile("status", []byte(dpkgStatusData), 0644),
+		fstest.CreateFile("os-release", []byte("ID=debian\n"), 0644),
+	)
+	require.NoError(t, err)
+	defer os.RemoveAll(host)
+
+	// absolute links are resolved in the scanned filesystem
+	dir, err := tmpdir(
+		fstest.CreateDir("etc", 0755),
+		fstest.Symlink(filepath.Join(host, "os-release"), "etc/os-release"),
+		fstest.CreateDir("var", 0755),
+		fstest.CreateDir("var/lib", 0755),
+		fstest.CreateDir("var/lib/dpkg", 0755),
+		fstest.Symlink(filepath.Join(host, "status"), "var/lib/dpkg/status"),
+	)
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pkgs, err := Scan(context.TODO(), dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pkgs))
+}
+
+func TestScanEmpty(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "buildkit-sbom")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pkgs, err := Scan(context.TODO(), dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pkgs))
+}
+
+func tmpdir(appliers ...fstest.Applier) (string, error) {
+	tmpdir, err := ioutil.TempDir("", "buildkit-sbom")
+	if err != nil {
+		return "", err
+	}
+	if err := fstest.Apply(appliers...).Apply(tmpdir); err != nil {
+		return "", err
+	}
+	return filepath.Clean(tmpdir), nil
+}
+
+// goBinary returns a minimal ELF file with a Go build information section
+// containing modInfo
+func goBinary(modInfo string) []byte {
+	var info bytes.Buffer
+	info.Write(buildInfoMagic)
+	info.Write([]byte{8, 2})
+	info.Write(make([]byte, buildInfoHeaderSize-info.Len()))
+	for _, s := range []string{"go1.13", modInfo} {
+		n := make([]byte, binary.MaxVarintLen64)
+		info.Write(n[:binary.PutUvarint(n, uint64(len(s)))])
+		info.WriteString(s)
+	}
+	shstrtab := "\x00.go.buildinfo\x00.shstrtab\x00"
+
+	infoOff := uint64(binary.Size(elf.Header64{}))
+	strOff := infoOff + uint64(info.Len())
+	shOff := strOff + uint64(len(shstrtab))
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, elf.Header64{
+		Ident:     [elf.EI_NIDENT]byte{0x7f, 'E', 'L', 'F', byte(elf.ELFCLASS64), byte(elf.ELFDATA2LSB), byte(elf.EV_CURRENT)},
+		Type:      uint16(elf.ET_EXEC),
+		Machine:   uint16(elf.EM_X86_64),
+		Version:   uint32(elf.EV_CURRENT),
+		Shoff:     shOff,
+		Ehsize:    uint16(infoOff),
+		Shentsize: uint16(binary.Size(elf.Section64{})),
+		Shnum:     3,
+		Shstrndx:  2,
+	})
+	buf.Write(info.Bytes())
+	buf.WriteString(shstrtab)
+	for _, s := range []elf.Section64{
+		{},
+		{Name: 1, Type: uint32(elf.SHT_PROGBITS), Flags: uint64(elf.SHF_ALLOC | elf.SHF_WRITE), Addr: 0x1000, Off: infoOff, Size: uint64(info.Len()), Addralign: buildInfoAlign},
+		{Name: 15, Type: uint32(elf.SHT_STRTAB), Off: strOff, Size: uint64(len(shstrtab)), Addralign: 1},
+	} {
+		binary.Write(&buf, binary.LittleEndian, s)
+	}
+	return buf.Bytes()
+}
@@ -46,6 +46,14 @@ func (s *TestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	s.stats[r.URL.Path].AllRequests += 1
 
+	for k := range resp.Header {
+		if r.Header.Get(k) != resp.Header.Get(k) {
+			w.WriteHeader(http.StatusUnauthorized)
+			s.mu.Unlock()
+			return
+		}
+	}
+
 	if resp.LastModified != nil {
 		w.Header().Set("Last-Modified", resp.LastModified.Format(time.RFC850))
 	}
@@ -77,6 +85,9 @@ type Response struct {
 	Content      []byte
 	Etag         string
 	LastModified *time.Time
+	// Header are the headers requests need to have, the server responds with
+	// 401 to requests without them
+	Header http.Header
 }
 
 type Stat struct {
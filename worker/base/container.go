@@ -0,0 +1,163 @@
+package base
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/executor"
+	"github.com/moby/buildkit/frontend"
+	"github.com/moby/buildkit/solver/llbsolver/ops"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/moby/buildkit/worker"
+	"github.com/pkg/errors"
+)
+
+// NewContainer creates the mounts of a container for a frontend. Writable
+// mounts are mutable refs on top of the solved results that are released
+// with the container.
+func (w *Worker) NewContainer(ctx context.Context, req frontend.NewContainerRequest) (_ frontend.Container, retErr error) {
+	ctr := &container{
+		executor: w.Executor,
+		netMode:  req.NetMode,
+		done:     make(chan struct{}),
+	}
+	defer func() {
+		if retErr != nil {
+			ctr.Release(context.TODO())
+		}
+	}()
+
+	for _, m := range req.Mounts {
+		if m.Dest == pb.RootMount && m.MountType != pb.MountType_BIND {
+			return nil, errors.Errorf("invalid mount type %s for %s", m.MountType.String(), m.Dest)
+		}
+
+		var ref cache.ImmutableRef
+		if m.Ref != nil {
+			workerRef, ok := m.Ref.Sys().(*worker.WorkerRef)
+			if !ok {
+				return nil, errors.Errorf("invalid reference for container %T", m.Ref.Sys())
+			}
+			if workerRef.Worker.ID() != w.ID() {
+				return nil, errors.Errorf("reference for mount %s is from worker %s", m.Dest, workerRef.Worker.ID())
+			}
+			ref = workerRef.ImmutableRef
+		}
+
+		var mountable cache.Mountable
+		switch m.MountType {
+		case pb.MountType_BIND:
+			if m.Readonly && ref != nil && m.Dest != pb.RootMount {
+				mountable = ref
+			} else {
+				active, err := w.CacheManager.New(ctx, ref, cache.WithDescription("container mount "+m.Dest))
+				if err != nil {
+					return nil, err
+				}
+				ctr.active = append(ctr.active, active)
+				mountable = active
+			}
+		case pb.MountType_TMPFS:
+			mountable = ops.NewTmpfs()
+		default:
+			return nil, errors.Errorf("mount type %s not supported for containers", m.MountType)
+		}
+
+		if m.Dest == pb.RootMount {
+			ctr.root = mountable
+			ctr.readonlyRootFS = m.Readonly
+		} else {
+			ctr.mounts = append(ctr.mounts, executor.Mount{Src: mountable, Dest: m.Dest, Readonly: m.Readonly, Selector: m.Selector})
+		}
+	}
+
+	if ctr.root == nil {
+		return nil, errors.Errorf("container has no root mount")
+	}
+
+	// sort mounts so parents are mounted first
+	sort.Slice(ctr.mounts, func(i, j int) bool {
+		return ctr.mounts[i].Dest < ctr.mounts[j].Dest
+	})
+
+	return ctr, nil
+}
+
+type container struct {
+	executor       executor.Executor
+	netMode        pb.NetMode
+	root           cache.Mountable
+	readonlyRootFS bool
+	mounts         []executor.Mount
+
+	mu       sync.Mutex
+	active   []cache.MutableRef
+	released bool
+	done     chan struct{}
+	running  sync.WaitGroup
+	// busy is set while a process runs. Every process is run by the executor
+	// as a new container on the same writable root, so processes can't run
+	// at the same time.
+	busy bool
+}
+
+func (c *container) Exec(ctx context.Context, process executor.ProcessInfo) error {
+	c.mu.Lock()
+	if c.released {
+		c.mu.Unlock()
+		return errors.Errorf("container is released")
+	}
+	if c.busy {
+		c.mu.Unlock()
+		return errors.Errorf("container is already running a process")
+	}
+	c.busy = true
+	c.running.Add(1)
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.busy = false
+		c.mu.Unlock()
+		c.running.Done()
+	}()
+
+	// processes are stopped when the container is released
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-c.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	process.Meta.NetMode = c.netMode
+	process.Meta.ReadonlyRootFS = c.readonlyRootFS
+	return c.executor.Exec(ctx, c.root, c.mounts, process)
+}
+
+// Release stops the running processes of the container and releases its
+// writable mounts
+func (c *container) Release(ctx context.Context) error {
+	c.mu.Lock()
+	if c.released {
+		c.mu.Unlock()
+		return nil
+	}
+	c.released = true
+	close(c.done)
+	c.mu.Unlock()
+
+	c.running.Wait()
+
+	var err error
+	for _, active := range c.active {
+		if err1 := active.Release(ctx); err1 != nil && err == nil {
+			err = err1
+		}
+	}
+	return err
+}
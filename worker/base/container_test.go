@@ -0,0 +1,55 @@
+package base
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerConcurrentExec(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+
+	e := &blockingExecutor{started: make(chan struct{}), release: make(chan struct{})}
+	ctr := &container{executor: e, done: make(chan struct{})}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ctr.Exec(ctx, executor.ProcessInfo{Meta: executor.Meta{Args: []string{"first"}}})
+	}()
+	<-e.started
+
+	// a second process can't run on the same root while the first one runs
+	err := ctr.Exec(ctx, executor.ProcessInfo{Meta: executor.Meta{Args: []string{"second"}}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "already running a process")
+
+	close(e.release)
+	require.NoError(t, <-errCh)
+
+	// the next process runs after the first one has exited
+	require.NoError(t, ctr.Exec(ctx, executor.ProcessInfo{Meta: executor.Meta{Args: []string{"third"}}}))
+	require.Equal(t, []string{"first", "third"}, e.args)
+
+	require.NoError(t, ctr.Release(ctx))
+	require.Error(t, ctr.Exec(ctx, executor.ProcessInfo{}))
+}
+
+// blockingExecutor blocks the first process until release is closed
+type blockingExecutor struct {
+	started chan struct{}
+	release chan struct{}
+	args    []string
+}
+
+func (e *blockingExecutor) Exec(ctx context.Context, rootfs cache.Mountable, mounts []executor.Mount, process executor.ProcessInfo) error {
+	e.args = append(e.args, process.Meta.Args...)
+	if len(e.args) == 1 {
+		close(e.started)
+		<-e.release
+	}
+	return nil
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/moby/buildkit/source/git"
 	"github.com/moby/buildkit/source/http"
 	"github.com/moby/buildkit/source/local"
+	"github.com/moby/buildkit/sourcepolicy"
 	"github.com/moby/buildkit/util/contentutil"
 	"github.com/moby/buildkit/util/progress"
 	"github.com/moby/buildkit/util/resolver"
@@ -60,6 +61,7 @@ type WorkerOpt struct {
 	Labels             map[string]string
 	Platforms          []specs.Platform
 	GCPolicy           []client.PruneInfo
+	DefaultTimeout     time.Duration
 	SessionManager     *session.Manager
 	MetadataStore      *metadata.Store
 	Executor           executor.Executor
@@ -69,6 +71,8 @@ type WorkerOpt struct {
 	Differ             diff.Comparer
 	ImageStore         images.Store // optional
 	ResolveOptionsFunc resolver.ResolveOptionsFunc
+	SourcePolicy       *sourcepolicy.Engine     // optional
+	OCIStores          map[string]content.Store // optional
 }
 
 // Worker is a local worker instance with dedicated snapshotter, cache, and so on.
@@ -93,6 +97,8 @@ func NewWorker(opt WorkerOpt) (*Worker, error) {
 		Snapshotter:     opt.Snapshotter,
 		MetadataStore:   opt.MetadataStore,
 		PruneRefChecker: imageRefChecker,
+		ContentStore:    opt.ContentStore,
+		Applier:         opt.Applier,
 	})
 	if err != nil {
 		return nil, err
@@ -102,8 +108,9 @@ func NewWorker(opt WorkerOpt) (*Worker, error) {
 	if err != nil {
 		return nil, err
 	}
+	sm.SetPolicy(opt.SourcePolicy)
 
-	is, err := containerimage.NewSource(containerimage.SourceOpt{
+	isOpt := containerimage.SourceOpt{
 		Snapshotter:    opt.Snapshotter,
 		ContentStore:   opt.ContentStore,
 		SessionManager: opt.SessionManager,
@@ -111,16 +118,27 @@ func NewWorker(opt WorkerOpt) (*Worker, error) {
 		ImageStore:     opt.ImageStore,
 		CacheAccessor:  cm,
 		ResolverOpt:    opt.ResolveOptionsFunc,
-	})
+		OCIStores:      opt.OCIStores,
+	}
+
+	is, err := containerimage.NewSource(isOpt)
 	if err != nil {
 		return nil, err
 	}
 
 	sm.Register(is)
 
+	ls, err := containerimage.NewOCILayoutSource(isOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	sm.Register(ls)
+
 	gs, err := git.NewSource(git.Opt{
-		CacheAccessor: cm,
-		MetadataStore: opt.MetadataStore,
+		CacheAccessor:  cm,
+		MetadataStore:  opt.MetadataStore,
+		SessionManager: opt.SessionManager,
 	})
 	if err != nil {
 		return nil, err
@@ -129,8 +147,9 @@ func NewWorker(opt WorkerOpt) (*Worker, error) {
 	sm.Register(gs)
 
 	hs, err := http.NewSource(http.Opt{
-		CacheAccessor: cm,
-		MetadataStore: opt.MetadataStore,
+		CacheAccessor:  cm,
+		MetadataStore:  opt.MetadataStore,
+		SessionManager: opt.SessionManager,
 	})
 	if err != nil {
 		return nil, err
@@ -223,6 +242,10 @@ func (w *Worker) GCPolicy() []client.PruneInfo {
 	return w.WorkerOpt.GCPolicy
 }
 
+func (w *Worker) DefaultTimeout() time.Duration {
+	return w.WorkerOpt.DefaultTimeout
+}
+
 func (w *Worker) LoadRef(id string, hidden bool) (cache.ImmutableRef, error) {
 	var opts []cache.RefOption
 	if hidden {
@@ -237,7 +260,13 @@ func (w *Worker) ResolveOp(v solver.Vertex, s frontend.FrontendLLBBridge) (solve
 		case *pb.Op_Source:
 			return ops.NewSourceOp(v, op, baseOp.Platform, w.SourceManager, w)
 		case *pb.Op_Exec:
-			return ops.NewExecOp(v, op, w.CacheManager, w.SessionManager, w.MetadataStore, w.Executor, w)
+			return ops.NewExecOp(v, op, s, w.CacheManager, w.SessionManager, w.MetadataStore, w.Executor, w)
+		case *pb.Op_File:
+			return ops.NewFileOp(v, op, w.CacheManager, w)
+		case *pb.Op_Merge:
+			return ops.NewMergeOp(v, op, w.CacheManager, w)
+		case *pb.Op_Diff:
+			return ops.NewDiffOp(v, op, w.CacheManager, w)
 		case *pb.Op_Build:
 			return ops.NewBuildOp(v, op, s, w)
 		}
@@ -251,6 +280,14 @@ func (w *Worker) ResolveImageConfig(ctx context.Context, ref string, opt gw.Reso
 	if !ok {
 		return "", nil, errors.Errorf("worker %q does not implement ResolveImageConfig", w.ID())
 	}
+	op := sourcepolicy.ImageSourceOp(ref)
+	if err := w.SourceManager.Evaluate(ctx, op); err != nil {
+		return "", nil, err
+	}
+	ref, err := sourcepolicy.ImageRef(op)
+	if err != nil {
+		return "", nil, err
+	}
 	return resolveImageConfig.ResolveImageConfig(ctx, ref, opt)
 }
 
@@ -264,7 +301,7 @@ func (w *Worker) Exec(ctx context.Context, meta executor.Meta, rootFS cache.Immu
 		return err
 	}
 	defer active.Release(context.TODO())
-	return w.Executor.Exec(ctx, meta, active, nil, stdin, stdout, stderr)
+	return w.Executor.Exec(ctx, active, nil, executor.ProcessInfo{Meta: meta, Stdin: stdin, Stdout: stdout, Stderr: stderr})
 }
 
 func (w *Worker) DiskUsage(ctx context.Context, opt client.DiskUsageInfo) ([]*client.UsageInfo, error) {
@@ -297,12 +334,29 @@ func (w *Worker) GetRemote(ctx context.Context, ref cache.ImmutableRef, createIf
 		return nil, errors.Errorf("invalid createdtimes/diffpairs")
 	}
 
+	// blobs of lazily pulled layers are read from the registry they were
+	// pulled from
+	lazyBlobs, err := cache.LazyBlobs(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	provider := contentutil.NewMultiProvider(w.ContentStore)
+	sizes := map[digest.Digest]int64{}
+	for _, lb := range lazyBlobs {
+		provider.Add(lb.Descriptor.Digest, lb.Provider)
+		sizes[lb.Descriptor.Digest] = lb.Descriptor.Size
+	}
+
 	descs := make([]ocispec.Descriptor, len(diffPairs))
 
 	for i, dp := range diffPairs {
-		info, err := w.ContentStore.Info(ctx, dp.Blobsum)
-		if err != nil {
-			return nil, err
+		size, ok := sizes[dp.Blobsum]
+		if !ok {
+			info, err := w.ContentStore.Info(ctx, dp.Blobsum)
+			if err != nil {
+				return nil, err
+			}
+			size = info.Size
 		}
 
 		tm, err := createdTimes[i].MarshalText()
@@ -312,7 +366,7 @@ func (w *Worker) GetRemote(ctx context.Context, ref cache.ImmutableRef, createIf
 
 		descs[i] = ocispec.Descriptor{
 			Digest:    dp.Blobsum,
-			Size:      info.Size,
+			Size:      size,
 			MediaType: images.MediaTypeDockerSchema2LayerGzip,
 			Annotations: map[string]string{
 				"containerd.io/uncompressed": dp.DiffID.String(),
@@ -323,7 +377,7 @@ func (w *Worker) GetRemote(ctx context.Context, ref cache.ImmutableRef, createIf
 
 	return &solver.Remote{
 		Descriptors: descs,
-		Provider:    w.ContentStore,
+		Provider:    provider,
 	}, nil
 }
 
@@ -47,7 +47,7 @@ func TestRuncWorker(t *testing.T) {
 		},
 	}
 	rootless := false
-	workerOpt, err := NewWorkerOpt(tmpdir, snFactory, rootless, nil)
+	workerOpt, err := NewWorkerOpt(tmpdir, snFactory, rootless, nil, nil)
 	require.NoError(t, err)
 
 	workerOpt.SessionManager, err = session.NewManager()
@@ -103,7 +103,7 @@ func TestRuncWorker(t *testing.T) {
 	}
 
 	stderr := bytes.NewBuffer(nil)
-	err = w.Executor.Exec(ctx, meta, snap, nil, nil, nil, &nopCloser{stderr})
+	err = w.Executor.Exec(ctx, snap, nil, executor.ProcessInfo{Meta: meta, Stderr: &nopCloser{stderr}})
 	require.Error(t, err) // Read-only root
 	// typical error is like `mkdir /.../rootfs/proc: read-only file system`.
 	// make sure the error is caused before running `echo foo > /bar`.
@@ -112,7 +112,7 @@ func TestRuncWorker(t *testing.T) {
 	root, err := w.CacheManager.New(ctx, snap)
 	require.NoError(t, err)
 
-	err = w.Executor.Exec(ctx, meta, root, nil, nil, nil, &nopCloser{stderr})
+	err = w.Executor.Exec(ctx, root, nil, executor.ProcessInfo{Meta: meta, Stderr: &nopCloser{stderr}})
 	require.NoError(t, err)
 
 	meta = executor.Meta{
@@ -120,7 +120,7 @@ func TestRuncWorker(t *testing.T) {
 		Cwd:  "/",
 	}
 
-	err = w.Executor.Exec(ctx, meta, root, nil, nil, nil, &nopCloser{stderr})
+	err = w.Executor.Exec(ctx, root, nil, executor.ProcessInfo{Meta: meta, Stderr: &nopCloser{stderr}})
 	require.NoError(t, err)
 
 	rf, err := root.Commit(ctx)